@@ -0,0 +1,12 @@
+package blocktime
+
+import (
+	"github.com/streamingfast/logging"
+	"go.uber.org/zap"
+)
+
+var zlog = zap.NewNop()
+
+func init() {
+	logging.Register("blocktime", &zlog)
+}
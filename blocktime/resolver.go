@@ -0,0 +1,382 @@
+package blocktime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dstore"
+	pbbstream "github.com/streamingfast/pbgo/sf/bstream/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	"github.com/graphprotocol/firehose-cosmos/transform"
+)
+
+// indexFilesRefreshInterval is the minimum time between two listings of the index files written since the last ones
+const indexFilesRefreshInterval = time.Minute
+
+// ErrNotFound is returned when no block produced at or after the requested time is available yet
+var ErrNotFound = errors.New("no block found at or after time")
+
+// Block is a block number along with its header time
+type Block struct {
+	Num  uint64
+	Time time.Time
+}
+
+// Resolver finds the first block produced at or after a time. It binary searches the block time index files when an
+// index store is configured, falling back to a binary search over the merged blocks files for the ranges that are not
+// indexed.
+type Resolver struct {
+	blocksStore        dstore.Store
+	indexStore         dstore.Store
+	possibleIndexSizes []uint64
+
+	indexFilesLock     sync.Mutex
+	indexFiles         []transform.IndexFile
+	indexFilesListedAt time.Time
+}
+
+// NewResolver creates a Resolver, indexStore can be nil. The index files of any size are used when possibleIndexSizes
+// is empty.
+func NewResolver(blocksStore dstore.Store, indexStore dstore.Store, possibleIndexSizes []uint64) *Resolver {
+	return &Resolver{
+		blocksStore:        blocksStore,
+		indexStore:         indexStore,
+		possibleIndexSizes: possibleIndexSizes,
+	}
+}
+
+// BlockAt returns the first block produced at or after t
+func (r *Resolver) BlockAt(ctx context.Context, t time.Time) (*Block, error) {
+	// No block is produced in the future, there is nothing to search
+	if t.After(time.Now()) {
+		return nil, ErrNotFound
+	}
+
+	searchFrom := bstream.GetProtocolFirstStreamableBlock
+
+	if r.indexStore != nil {
+		blk, indexedStop, err := r.searchIndexFiles(ctx, t)
+		if err != nil || blk != nil {
+			return blk, err
+		}
+		if indexedStop > searchFrom {
+			searchFrom = indexedStop
+		}
+	}
+
+	blk, err := r.searchMergedBlocks(ctx, searchFrom, 0, t)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil {
+		return nil, ErrNotFound
+	}
+	return blk, nil
+}
+
+// searchIndexFiles looks for t in the listed index files, listing the ones written since when t is after them. When
+// t is after every indexed block, it returns the block at which the indexes stop.
+func (r *Resolver) searchIndexFiles(ctx context.Context, t time.Time) (*Block, uint64, error) {
+	files, err := r.listIndexFiles(ctx, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	blk, indexedStop, err := r.searchIndexes(ctx, files, t)
+	if errors.Is(err, dstore.ErrNotFound) {
+		// Some files were deleted since they were listed (ex: by `tools compact-indexes --delete-source`)
+		r.resetIndexFiles()
+		if files, err = r.listIndexFiles(ctx, false); err != nil {
+			return nil, 0, err
+		}
+		blk, indexedStop, err = r.searchIndexes(ctx, files, t)
+	}
+	if err != nil || blk != nil {
+		return blk, indexedStop, err
+	}
+
+	// t is after the last known index file, new ones may have been written since they were listed
+	refreshed, err := r.listIndexFiles(ctx, true)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(refreshed) == len(files) {
+		return nil, indexedStop, nil
+	}
+	return r.searchIndexes(ctx, refreshed, t)
+}
+
+// searchIndexes looks for t in the index files, the blocks in the gaps between them are searched in the merged blocks.
+// When t is after every indexed block, it returns the block at which the indexes stop.
+func (r *Resolver) searchIndexes(ctx context.Context, files []transform.IndexFile, t time.Time) (*Block, uint64, error) {
+	if len(files) == 0 {
+		return nil, 0, nil
+	}
+
+	// Find the first index file holding a block at or after t
+	var found []*Block
+	lo, hi := 0, len(files)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		blocks, err := r.readIndexFile(ctx, files[mid])
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(blocks) == 0 || blocks[len(blocks)-1].Time.Before(t) {
+			lo = mid + 1
+			continue
+		}
+		hi = mid
+		found = blocks
+	}
+	if lo == len(files) {
+		return nil, files[len(files)-1].StopBlockNum(), nil
+	}
+
+	gapStart := bstream.GetProtocolFirstStreamableBlock
+	if lo > 0 {
+		gapStart = files[lo-1].StopBlockNum()
+	}
+	if gapStart < files[lo].BaseBlockNum && found[0].Time.After(t) {
+		zlog.Debug("searching merged blocks between index files", zap.Uint64("start_block", gapStart), zap.Uint64("stop_block", files[lo].BaseBlockNum))
+		blk, err := r.searchMergedBlocks(ctx, gapStart, files[lo].BaseBlockNum, t)
+		if err != nil || blk != nil {
+			return blk, 0, err
+		}
+	}
+
+	i := sort.Search(len(found), func(i int) bool { return !found[i].Time.Before(t) })
+	return found[i], 0, nil
+}
+
+// listIndexFiles returns the block time index files not covered by a larger one, sorted by base block num. The list
+// is cached, refresh listing the files written after the cached ones at most once every indexFilesRefreshInterval.
+// The concurrent calls wait for the same listing.
+func (r *Resolver) listIndexFiles(ctx context.Context, refresh bool) ([]transform.IndexFile, error) {
+	r.indexFilesLock.Lock()
+	defer r.indexFilesLock.Unlock()
+
+	if r.indexFiles != nil && (!refresh || time.Since(r.indexFilesListedAt) < indexFilesRefreshInterval) {
+		return r.indexFiles, nil
+	}
+
+	coveredStop := uint64(0)
+	if len(r.indexFiles) > 0 {
+		coveredStop = r.indexFiles[len(r.indexFiles)-1].StopBlockNum()
+	}
+	listed, err := transform.ListIndexFilesFrom(ctx, r.indexStore, coveredStop)
+	if err != nil {
+		return nil, fmt.Errorf("listing index files: %w", err)
+	}
+
+	sizes := make(map[uint64]bool)
+	for _, size := range r.possibleIndexSizes {
+		sizes[size] = true
+	}
+
+	var files []transform.IndexFile
+	for _, f := range listed {
+		if f.ShortName != transform.BlockTimeIndexShortName || (len(sizes) > 0 && !sizes[f.Size]) {
+			continue
+		}
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].BaseBlockNum != files[j].BaseBlockNum {
+			return files[i].BaseBlockNum < files[j].BaseBlockNum
+		}
+		return files[i].Size > files[j].Size
+	})
+
+	if r.indexFiles == nil {
+		r.indexFiles = []transform.IndexFile{}
+	}
+	for _, f := range files {
+		if f.BaseBlockNum < coveredStop {
+			continue
+		}
+		r.indexFiles = append(r.indexFiles, f)
+		coveredStop = f.StopBlockNum()
+	}
+	r.indexFilesListedAt = time.Now()
+
+	zlog.Debug("listed block time index files", zap.Int("count", len(r.indexFiles)), zap.Int("new_count", len(files)))
+	return r.indexFiles, nil
+}
+
+// resetIndexFiles drops the cached index files, so that they are all listed again
+func (r *Resolver) resetIndexFiles() {
+	r.indexFilesLock.Lock()
+	defer r.indexFilesLock.Unlock()
+
+	r.indexFiles = nil
+}
+
+// readIndexFile returns the blocks of the block time index file, sorted by number
+func (r *Resolver) readIndexFile(ctx context.Context, f transform.IndexFile) ([]*Block, error) {
+	reader, err := r.indexStore.OpenObject(ctx, f.Filename())
+	if err != nil {
+		return nil, fmt.Errorf("opening index file %q: %w", f.Filename(), err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading index file %q: %w", f.Filename(), err)
+	}
+
+	index := &pbbstream.GenericBlockIndex{}
+	if err := proto.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("unmarshalling index file %q: %w", f.Filename(), err)
+	}
+
+	var out []*Block
+	for _, kv := range index.Kv {
+		t, err := transform.ParseBlockTimeIndexKey(string(kv.Key))
+		if err != nil {
+			return nil, fmt.Errorf("index file %q: %w", f.Filename(), err)
+		}
+		bitmap := roaring64.NewBitmap()
+		if err := bitmap.UnmarshalBinary(kv.Bitmap); err != nil {
+			return nil, fmt.Errorf("unmarshalling bitmap of key %q in index file %q: %w", string(kv.Key), f.Filename(), err)
+		}
+		if bitmap.IsEmpty() {
+			continue
+		}
+		out = append(out, &Block{Num: bitmap.Minimum(), Time: t})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Num < out[j].Num })
+	return out, nil
+}
+
+// searchMergedBlocks binary searches the merged blocks files for the first block at or after t, within the range
+// [startBlock, stopBlock), stopBlock being unbounded when 0. It returns nil when there is no such block. The files are
+// not listed, their names being derived from the block numbers.
+func (r *Resolver) searchMergedBlocks(ctx context.Context, startBlock, stopBlock uint64, t time.Time) (*Block, error) {
+	firstBase := startBlock / codec.MergedBundleSize * codec.MergedBundleSize
+	maxCount := (math.MaxUint64-firstBase)/codec.MergedBundleSize + 1
+	if stopBlock != 0 {
+		if stopBlock <= startBlock {
+			return nil, nil
+		}
+		maxCount = (stopBlock-1)/codec.MergedBundleSize - firstBase/codec.MergedBundleSize + 1
+	}
+	count, err := r.countMergedBundles(ctx, firstBase, maxCount)
+	if err != nil {
+		return nil, err
+	}
+
+	inRange := func(blocks []*Block) []*Block {
+		var out []*Block
+		for _, blk := range blocks {
+			if blk.Num >= startBlock && (stopBlock == 0 || blk.Num < stopBlock) {
+				out = append(out, blk)
+			}
+		}
+		return out
+	}
+
+	var found []*Block
+	lo, hi := uint64(0), count
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		blocks, err := r.readMergedBundle(ctx, firstBase+mid*codec.MergedBundleSize)
+		if err != nil {
+			return nil, err
+		}
+		blocks = inRange(blocks)
+		if len(blocks) == 0 || blocks[len(blocks)-1].Time.Before(t) {
+			lo = mid + 1
+			continue
+		}
+		hi = mid
+		found = blocks
+	}
+	if lo == count {
+		return nil, nil
+	}
+
+	i := sort.Search(len(found), func(i int) bool { return !found[i].Time.Before(t) })
+	return found[i], nil
+}
+
+// countMergedBundles returns the number of consecutive merged blocks files starting at firstBase, up to maxCount. The
+// files being written in order, it probes exponentially growing offsets then binary searches the last existing file.
+func (r *Resolver) countMergedBundles(ctx context.Context, firstBase, maxCount uint64) (uint64, error) {
+	exists := func(i uint64) (bool, error) {
+		filename := fmt.Sprintf("%010d", firstBase+i*codec.MergedBundleSize)
+		found, err := r.blocksStore.FileExists(ctx, filename)
+		if err != nil {
+			return false, fmt.Errorf("checking merged blocks file %q: %w", filename, err)
+		}
+		return found, nil
+	}
+
+	// The files before count exist, the one at probe is missing or out of range
+	count, probe := uint64(0), uint64(0)
+	for probe < maxCount {
+		found, err := exists(probe)
+		if err != nil {
+			return 0, err
+		}
+		if !found {
+			break
+		}
+		count = probe + 1
+		probe = probe*2 + 1
+	}
+	if probe > maxCount {
+		probe = maxCount
+	}
+
+	for count < probe {
+		mid := count + (probe-count)/2
+		found, err := exists(mid)
+		if err != nil {
+			return 0, err
+		}
+		if found {
+			count = mid + 1
+		} else {
+			probe = mid
+		}
+	}
+	return count, nil
+}
+
+func (r *Resolver) readMergedBundle(ctx context.Context, baseNum uint64) ([]*Block, error) {
+	filename := fmt.Sprintf("%010d", baseNum)
+	reader, err := r.blocksStore.OpenObject(ctx, filename)
+	if err != nil {
+		return nil, fmt.Errorf("opening merged blocks file %q: %w", filename, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := codec.NewBlockReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading merged blocks file %q: %w", filename, err)
+	}
+
+	var out []*Block
+	for {
+		blk, err := blockReader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return out, nil
+			}
+			return nil, fmt.Errorf("reading merged blocks file %q: %w", filename, err)
+		}
+		out = append(out, &Block{Num: blk.Number, Time: blk.Timestamp.UTC()})
+	}
+}
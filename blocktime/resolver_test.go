@@ -0,0 +1,241 @@
+package blocktime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	"github.com/graphprotocol/firehose-cosmos/transform"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/streamingfast/dstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGenesisTime = 1600000000
+
+func testBlock(height uint64) *pbcosmos.Block {
+	return &pbcosmos.Block{
+		Header: &pbcosmos.Header{
+			Height: height,
+			Hash:   []byte{byte(height), byte(height >> 8)},
+			Time:   &pbcosmos.Timestamp{Seconds: testBlockTime(height).Unix()},
+		},
+	}
+}
+
+// testBlockTime is the time of the block at height, blocks being produced every 6 seconds
+func testBlockTime(height uint64) time.Time {
+	return time.Unix(testGenesisTime+int64(height)*6, 0).UTC()
+}
+
+// testStores writes the merged blocks files of blocks 0 to 399, and the block time index files covering the blocks 0
+// to 99 and 200 to 299
+func testStores(t *testing.T) (blocksStore dstore.Store, indexStore dstore.Store) {
+	t.Helper()
+	ctx := context.Background()
+
+	blocksStore, err := dstore.NewDBinStore("file://" + t.TempDir())
+	require.NoError(t, err)
+	indexStore, err = dstore.NewStore("file://"+t.TempDir(), "", "", false)
+	require.NoError(t, err)
+
+	for baseNum := uint64(0); baseNum < 400; baseNum += codec.MergedBundleSize {
+		buf := &bytes.Buffer{}
+		writer, err := codec.NewBlockWriter(buf)
+		require.NoError(t, err)
+		for height := baseNum; height < baseNum+codec.MergedBundleSize; height++ {
+			blk, err := codec.FromProto(testBlock(height))
+			require.NoError(t, err)
+			require.NoError(t, writer.Write(blk))
+		}
+		require.NoError(t, blocksStore.WriteObject(ctx, fmt.Sprintf("%010d", baseNum), buf))
+	}
+
+	for _, indexed := range [][2]uint64{{0, 101}, {200, 301}} {
+		indexer := transform.NewBlockTimeIndexer(indexStore, 100, indexed[0])
+		for height := indexed[0]; height < indexed[1]; height++ {
+			indexer.ProcessBlock(testBlock(height))
+		}
+	}
+
+	return blocksStore, indexStore
+}
+
+func TestResolver_BlockAt(t *testing.T) {
+	blocksStore, indexStore := testStores(t)
+
+	tests := []struct {
+		name          string
+		time          time.Time
+		expectedBlock uint64
+		expectedErr   error
+	}{
+		{name: "before first block", time: time.Unix(0, 0), expectedBlock: 0},
+		{name: "exact block time", time: testBlockTime(50), expectedBlock: 50},
+		{name: "between blocks", time: testBlockTime(50).Add(time.Second), expectedBlock: 51},
+		{name: "sub-second", time: testBlockTime(50).Add(time.Nanosecond), expectedBlock: 51},
+		{name: "last block of index file", time: testBlockTime(99), expectedBlock: 99},
+		{name: "gap between index files", time: testBlockTime(150).Add(-time.Second), expectedBlock: 150},
+		{name: "first block after gap", time: testBlockTime(199).Add(time.Second), expectedBlock: 200},
+		{name: "second index file", time: testBlockTime(250), expectedBlock: 250},
+		{name: "after indexes", time: testBlockTime(350), expectedBlock: 350},
+		{name: "last block", time: testBlockTime(399), expectedBlock: 399},
+		{name: "after last block", time: testBlockTime(399).Add(time.Second), expectedErr: ErrNotFound},
+	}
+
+	resolvers := map[string]*Resolver{
+		"indexes":       NewResolver(blocksStore, indexStore, []uint64{100}),
+		"merged blocks": NewResolver(blocksStore, nil, nil),
+	}
+
+	for resolverName, resolver := range resolvers {
+		for _, test := range tests {
+			t.Run(resolverName+"/"+test.name, func(t *testing.T) {
+				blk, err := resolver.BlockAt(context.Background(), test.time)
+				if test.expectedErr != nil {
+					assert.ErrorIs(t, err, test.expectedErr)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, test.expectedBlock, blk.Num)
+				assert.True(t, testBlockTime(test.expectedBlock).Equal(blk.Time))
+			})
+		}
+	}
+}
+
+// unlistableStore fails the listings of a store, the merged blocks being looked up by name
+type unlistableStore struct {
+	dstore.Store
+}
+
+func (s unlistableStore) Walk(context.Context, string, func(string) error) error {
+	return errors.New("listing not allowed")
+}
+
+func (s unlistableStore) WalkFrom(context.Context, string, string, func(string) error) error {
+	return errors.New("listing not allowed")
+}
+
+func TestResolver_SearchMergedBlocks(t *testing.T) {
+	blocksStore, _ := testStores(t)
+	resolver := NewResolver(unlistableStore{blocksStore}, nil, nil)
+	ctx := context.Background()
+
+	blk, err := resolver.searchMergedBlocks(ctx, 0, 0, testBlockTime(250))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(250), blk.Num)
+
+	blk, err = resolver.searchMergedBlocks(ctx, 120, 0, testBlockTime(50))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(120), blk.Num)
+
+	// The range is bounded by stopBlock, then by the last merged blocks file
+	blk, err = resolver.searchMergedBlocks(ctx, 120, 180, testBlockTime(250))
+	require.NoError(t, err)
+	assert.Nil(t, blk)
+
+	blk, err = resolver.searchMergedBlocks(ctx, 0, 0, testBlockTime(399).Add(time.Second))
+	require.NoError(t, err)
+	assert.Nil(t, blk)
+
+	blk, err = resolver.searchMergedBlocks(ctx, 400, 0, testBlockTime(0))
+	require.NoError(t, err)
+	assert.Nil(t, blk)
+}
+
+func TestResolver_IndexFiles(t *testing.T) {
+	_, indexStore := testStores(t)
+
+	files, err := NewResolver(nil, indexStore, nil).listIndexFiles(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, []transform.IndexFile{
+		{BaseBlockNum: 0, Size: 100, ShortName: transform.BlockTimeIndexShortName},
+		{BaseBlockNum: 200, Size: 100, ShortName: transform.BlockTimeIndexShortName},
+	}, files)
+
+	files, err = NewResolver(nil, indexStore, []uint64{1000}).listIndexFiles(context.Background(), false)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+// walkCountingStore records the files walked in a store
+type walkCountingStore struct {
+	dstore.Store
+	walked []string
+}
+
+func (s *walkCountingStore) WalkFrom(ctx context.Context, prefix, startingPoint string, f func(filename string) error) error {
+	return s.Store.WalkFrom(ctx, prefix, startingPoint, func(filename string) error {
+		s.walked = append(s.walked, filename)
+		return f(filename)
+	})
+}
+
+func TestResolver_RefreshIndexFiles(t *testing.T) {
+	ctx := context.Background()
+	blocksStore, indexStore := testStores(t)
+	countingStore := &walkCountingStore{Store: indexStore}
+	resolver := NewResolver(blocksStore, countingStore, []uint64{100})
+
+	files, err := resolver.listIndexFiles(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	indexer := transform.NewBlockTimeIndexer(indexStore, 100, 300)
+	for height := uint64(300); height < 401; height++ {
+		indexer.ProcessBlock(testBlock(height))
+	}
+
+	// The blocks after the listed index files are searched in the merged blocks, the files being listed again at
+	// most once every indexFilesRefreshInterval
+	countingStore.walked = nil
+	blk, err := resolver.BlockAt(ctx, testBlockTime(350))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(350), blk.Num)
+	assert.Empty(t, countingStore.walked)
+
+	// The refresh only lists the files written after the listed ones
+	resolver.indexFilesListedAt = time.Now().Add(-indexFilesRefreshInterval)
+	blk, err = resolver.BlockAt(ctx, testBlockTime(350))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(350), blk.Num)
+	assert.Equal(t, []string{"0000000300.100.blocktime.idx"}, countingStore.walked)
+
+	files, err = resolver.listIndexFiles(ctx, false)
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+}
+
+func TestResolver_DeletedIndexFile(t *testing.T) {
+	ctx := context.Background()
+	blocksStore, indexStore := testStores(t)
+	resolver := NewResolver(blocksStore, indexStore, []uint64{100})
+
+	_, err := resolver.listIndexFiles(ctx, false)
+	require.NoError(t, err)
+	require.NoError(t, indexStore.DeleteObject(ctx, "0000000200.100.blocktime.idx"))
+
+	// The index files are listed again, the blocks no longer indexed being searched in the merged blocks
+	blk, err := resolver.BlockAt(ctx, testBlockTime(250))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(250), blk.Num)
+
+	files, err := resolver.listIndexFiles(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, []transform.IndexFile{{BaseBlockNum: 0, Size: 100, ShortName: transform.BlockTimeIndexShortName}}, files)
+}
+
+func TestResolver_FutureTime(t *testing.T) {
+	// The stores are not read
+	resolver := NewResolver(nil, nil, nil)
+
+	_, err := resolver.BlockAt(context.Background(), time.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,52 @@
+package blocktime
+
+import (
+	"context"
+	"errors"
+
+	pbfcblocktime "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/blocktime/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server exposes a Resolver as the `sf.firecosmos.blocktime.v1.BlockTime` gRPC service
+type Server struct {
+	pbfcblocktime.UnimplementedBlockTimeServer
+
+	resolver *Resolver
+}
+
+func NewServer(resolver *Resolver) *Server {
+	return &Server{resolver: resolver}
+}
+
+// Register adds the service to the gRPC server
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	pbfcblocktime.RegisterBlockTimeServer(registrar, s)
+}
+
+func (s *Server) BlockAt(ctx context.Context, req *pbfcblocktime.BlockAtRequest) (*pbfcblocktime.BlockAtResponse, error) {
+	if req.Time == nil {
+		return nil, status.Error(codes.InvalidArgument, "time is required")
+	}
+	if err := req.Time.CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time: %s", err)
+	}
+
+	blk, err := s.resolver.BlockAt(ctx, req.Time.AsTime())
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no block found at or after %s", req.Time.AsTime())
+		}
+		zlog.Warn("resolving block at time", zap.Time("time", req.Time.AsTime()), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "resolving block at time: %s", err)
+	}
+
+	return &pbfcblocktime.BlockAtResponse{
+		BlockNum:  blk.Num,
+		BlockTime: timestamppb.New(blk.Time),
+	}, nil
+}
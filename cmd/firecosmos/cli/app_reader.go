@@ -56,11 +56,17 @@ func init() {
 		flags.String("reader-node-env", "", "Node process env vars")
 		flags.String("reader-node-logs-filter", "", "Node process log filter expression")
 		flags.String("reader-oneblock-suffix", "default", "suffix appended to one-block-files to identify a specific reader process in redundant mode")
+		flags.String("reader-attributes-encoding", string(codec.AttributeEncodingPlain), "Encoding of the event attributes emitted by the node, one of (plain, base64, auto). Base64 encoded attributes (Tendermint up to v0.34) are decoded before blocks are written, auto detecting them on each block")
+		flags.Uint64("reader-attributes-until-height", 0, "Last block height which event attributes can be base64 encoded, usually the height of the chain upgrade to Tendermint v0.35+ (0 for no limit)")
 
 		return nil
 	}
 
 	initFunc := func(runtime *launcher.Runtime) (err error) {
+		if _, err := codec.ParseAttributeEncoding(viper.GetString("reader-attributes-encoding")); err != nil {
+			return err
+		}
+
 		mode := viper.GetString("reader-mode")
 
 		switch mode {
@@ -87,8 +93,14 @@ func init() {
 		blocksChanCapacity := viper.GetInt("reader-blocks-chan-capacity")
 		readinessMaxLatency := viper.GetDuration("reader-readiness-max-latency")
 
+		attributeEncoding, err := codec.ParseAttributeEncoding(viper.GetString("reader-attributes-encoding"))
+		if err != nil {
+			return nil, err
+		}
+		attributeNormalizer := codec.NewAttributeNormalizer(attributeEncoding, viper.GetUint64("reader-attributes-until-height"))
+
 		consoleReaderFactory := func(lines chan string) (mindreader.ConsolerReader, error) {
-			return codec.NewConsoleReader(lines, zlog)
+			return codec.NewConsoleReader(lines, zlog, codec.WithAttributeNormalizer(attributeNormalizer))
 		}
 
 		blockStreamServer := blockstream.NewUnmanagedServer(blockstream.ServerOptionWithLogger(appLogger))
@@ -40,6 +40,7 @@ func initCommonFlags(flags *pflag.FlagSet) {
 	// General
 	flags.IntP("verbose", "v", 3, "Enables verbose output (-vvvv for max verbosity)")
 	flags.String("log-format", "text", "Logging format")
+	flags.Bool("log-to-stderr", false, "Write the console logs to stderr instead of stdout, required by the commands writing their output to stdout")
 	flags.StringP("config", "c", "firehose.yml", "Configuration file for the Firehose")
 	flags.StringP("data-dir", "d", DataDir, "Path to data storage for all components of Firehose")
 
@@ -52,6 +53,7 @@ func initCommonFlags(flags *pflag.FlagSet) {
 	flags.String("common-one-block-store-url", OneBlockStoreURL, "Store URL (with prefix) to read/write one-block files")
 	flags.String("common-live-blocks-addr", RelayerServingAddr, "GRPC endpoint to get real-time blocks")
 	flags.Uint64("common-first-streamable-block", FirstStreamableBlock, "First streamable block number")
+	flags.Int("common-blocks-version", 1, "Version of the one-block and merged blocks files written, 1 for raw blocks or 2 for zstd compressed blocks (for stores not compressing whole files, like the one-block files written by the reader), both versions are always read")
 
 	flags.String("common-index-store-url", IndexStoreURL, "[COMMON] Store URL (with prefix) to read/write index files.")
 	flags.IntSlice("common-block-index-sizes", []int{100000, 100000, 10000, 1000}, "index bundle sizes that that are considered valid when looking for block indexes")
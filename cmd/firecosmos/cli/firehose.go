@@ -8,13 +8,17 @@ import (
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 
+	"github.com/graphprotocol/firehose-cosmos/blocktime"
 	sftransform "github.com/graphprotocol/firehose-cosmos/transform"
+	"github.com/streamingfast/bstream/hub"
 	"github.com/streamingfast/bstream/transform"
 	dauthAuthenticator "github.com/streamingfast/dauth/authenticator"
 	_ "github.com/streamingfast/dauth/authenticator/null"
+	dgrpcserver "github.com/streamingfast/dgrpc/server"
 	"github.com/streamingfast/dlauncher/launcher"
 	"github.com/streamingfast/dmetering"
 	"github.com/streamingfast/dmetrics"
+	"github.com/streamingfast/dstore"
 	firehoseApp "github.com/streamingfast/firehose/app/firehose"
 	"github.com/streamingfast/logging"
 )
@@ -31,6 +35,7 @@ func init() {
 
 	registerFlags := func(cmd *cobra.Command) error {
 		cmd.Flags().String("firehose-grpc-listen-addr", FirehoseGRPCServingAddr, "Address on which the firehose will listen")
+		cmd.Flags().Bool("firehose-message-type-filter-prune-events", false, "When the message type filter drops messages from a transaction, also drop the events emitted by those messages (disabled by default, every event being kept)")
 		return nil
 	}
 
@@ -73,7 +78,17 @@ func init() {
 		registry := transform.NewRegistry()
 		registry.Register(sftransform.EventOriginFilterFactory(indexStore, possibleIndexSizes))
 		registry.Register(sftransform.EventTypeFilterFactory(indexStore, possibleIndexSizes))
-		registry.Register(sftransform.MessageTypeFilterFactory(indexStore, possibleIndexSizes))
+		registry.Register(sftransform.MessageTypeFilterFactory(indexStore, possibleIndexSizes, viper.GetBool("firehose-message-type-filter-prune-events")))
+		registry.Register(sftransform.WasmContractFilterFactory(indexStore, possibleIndexSizes))
+		registry.Register(sftransform.IbcPacketFilterFactory(indexStore, possibleIndexSizes))
+		registry.Register(sftransform.ValidatorSetChangeFilterFactory(indexStore, possibleIndexSizes))
+		registry.Register(sftransform.HeaderOnlyFactory())
+		registry.Register(sftransform.FeeAccountingFactory())
+		registry.Register(sftransform.GovernanceFilterFactory(indexStore, possibleIndexSizes))
+		registry.Register(sftransform.BalanceChangeFilterFactory(indexStore, possibleIndexSizes))
+		registry.Register(sftransform.StakingFilterFactory(indexStore, possibleIndexSizes))
+		registry.Register(sftransform.EventsOnlyFactory())
+		registry.Register(sftransform.NormalizeAttributesFactory())
 
 		return firehoseApp.New(appLogger,
 			&firehoseApp.Config{
@@ -89,6 +104,10 @@ func init() {
 				HeadTimeDriftMetric:   headTimeDriftmetric,
 				HeadBlockNumberMetric: headBlockNumMetric,
 				TransformRegistry:     registry,
+				RegisterServiceExtension: func(server dgrpcserver.Server, mergedBlocksStore dstore.Store, _ dstore.Store, _ *hub.ForkableHub, _ *zap.Logger) {
+					resolver := blocktime.NewResolver(mergedBlocksStore, indexStore, possibleIndexSizes)
+					server.RegisterService(blocktime.NewServer(resolver).Register)
+				},
 			}), nil
 	}
 
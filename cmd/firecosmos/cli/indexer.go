@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dlauncher/launcher"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose"
+	pbfirehose "github.com/streamingfast/pbgo/sf/firehose/v2"
+	"github.com/streamingfast/shutter"
+	"go.uber.org/zap"
+
+	sftransform "github.com/graphprotocol/firehose-cosmos/transform"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+// defaultIndexerIndexes are the indexes written by the indexer app when indexer-indexes is not set, the ones looked up
+// by the event type, event origin and message type filters of firehose
+var defaultIndexerIndexes = []string{
+	sftransform.EventTypeIndexShortName,
+	sftransform.EventOriginIndexShortName,
+	sftransform.MessageTypeIndexShortName,
+}
+
+func init() {
+	registerFlags := func(cmd *cobra.Command) error {
+		cmd.Flags().Uint64("indexer-indexes-size", 1000, "Size of index bundles that will be created, must be part of common-block-index-sizes. Smaller bundles make indexes available closer to the head")
+		cmd.Flags().StringSlice("indexer-indexes", defaultIndexerIndexes, fmt.Sprintf("Indexes to write, any of: %s", strings.Join(sftransform.RegisteredIndexers(), ", ")))
+		cmd.Flags().Uint64("indexer-start-block", 0, "Block from which to start indexing when no index is found, defaults to common-first-streamable-block when 0. A start block not aligned on indexer-indexes-size, other than the first streamable block, starts indexing at the next index bundle")
+		return nil
+	}
+
+	factoryFunc := func(runtime *launcher.Runtime) (launcher.App, error) {
+		mergedBlocksStoreURL, _, err := GetCommonStoresURLs(runtime.AbsDataDir)
+		if err != nil {
+			return nil, err
+		}
+		indexStore, possibleIndexSizes, err := GetIndexStore(runtime.AbsDataDir)
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize indexes: %w", err)
+		}
+		if indexStore == nil {
+			return nil, errors.New("indexer requires common-index-store-url to be set")
+		}
+
+		indexSize := viper.GetUint64("indexer-indexes-size")
+		validSize := false
+		for _, size := range possibleIndexSizes {
+			if size == indexSize {
+				validSize = true
+			}
+		}
+		if !validSize {
+			return nil, fmt.Errorf("indexer-indexes-size %d must be part of common-block-index-sizes %v, firehose would not find the indexes otherwise", indexSize, possibleIndexSizes)
+		}
+
+		startBlock := viper.GetUint64("indexer-start-block")
+		if startBlock == 0 {
+			startBlock = bstream.GetProtocolFirstStreamableBlock
+		}
+
+		return &IndexerApp{
+			Shutter:              shutter.New(),
+			mergedBlocksStoreURL: mergedBlocksStoreURL,
+			indexStore:           indexStore,
+			indexSize:            indexSize,
+			lookupIndexSizes:     possibleIndexSizes,
+			indexers:             viper.GetStringSlice("indexer-indexes"),
+			startBlock:           startBlock,
+		}, nil
+	}
+
+	launcher.RegisterApp(zlog, &launcher.AppDef{
+		ID:            "indexer",
+		Title:         "Indexer",
+		Description:   "Writes block indexes to common-index-store-url from merged blocks as they appear",
+		RegisterFlags: registerFlags,
+		FactoryFunc:   factoryFunc,
+	})
+}
+
+type IndexerApp struct {
+	*shutter.Shutter
+
+	mergedBlocksStoreURL string
+	indexStore           dstore.Store
+	indexSize            uint64
+	lookupIndexSizes     []uint64
+	indexers             []string
+	startBlock           uint64
+}
+
+func (app *IndexerApp) Run() error {
+	mergedBlocksStore, err := dstore.NewDBinStore(app.mergedBlocksStoreURL)
+	if err != nil {
+		return fmt.Errorf("failed setting up block store from url %q: %w", app.mergedBlocksStoreURL, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	app.OnTerminating(func(_ error) {
+		cancel()
+	})
+
+	indexers, err := sftransform.NewIndexerSet(ctx, app.indexStore, app.indexers, app.indexSize, app.lookupIndexSizes, app.startBlock, 0)
+	if err != nil {
+		return err
+	}
+	zlog.Info("starting indexer", zap.Strings("indexers", app.indexers), zap.Uint64("start_block", indexers.StartBlock()))
+
+	handler := bstream.HandlerFunc(func(blk *bstream.Block, obj interface{}) error {
+		indexers.ProcessBlock(blk.ToProtocol().(*pbcosmos.Block))
+		return nil
+	})
+
+	req := &pbfirehose.Request{
+		StartBlockNum:   int64(indexers.StartBlock()),
+		FinalBlocksOnly: true,
+	}
+
+	streamFactory := firehose.NewStreamFactory(mergedBlocksStore, nil, nil, nil)
+	s, err := streamFactory.New(ctx, handler, req, true, zlog)
+	if err != nil {
+		return fmt.Errorf("getting firehose stream: %w", err)
+	}
+
+	go func() {
+		err := s.Run(ctx)
+		if ctx.Err() != nil {
+			err = nil
+		}
+		zlog.Info("indexer stream terminated", zap.Error(err))
+		app.Shutdown(err)
+	}()
+
+	return nil
+}
@@ -75,6 +75,7 @@ func preRun(cmd *cobra.Command, args []string) error {
 		Verbosity:     viper.GetInt("verbose"),
 		LogFormat:     viper.GetString("log-format"),
 		LogToFile:     viper.GetBool("log-to-file"),
+		LogToStderr:   viper.GetBool("log-to-stderr"),
 		LogListenAddr: viper.GetString("log-level-switcher-listen-addr"),
 	})
 
@@ -27,6 +27,9 @@ func runStartCommand(cmd *cobra.Command, args []string) error {
 	tracker := bstream.NewTracker(50)
 
 	codec.SetFirstStreamableBlock(viper.GetUint64("common-first-streamable-block"))
+	if err := codec.SetBlockWriterVersion(viper.GetInt("common-blocks-version")); err != nil {
+		return err
+	}
 	if err := codec.Validate(); err != nil {
 		return err
 	}
@@ -0,0 +1,130 @@
+package codec
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+// AttributeEncoding is the encoding of the event attributes keys and values emitted by a chain. Chains running
+// Tendermint up to v0.34 (Cosmos SDK up to v0.45) emit them base64 encoded, later ones as plain strings.
+type AttributeEncoding string
+
+const (
+	// AttributeEncodingPlain keeps the attributes as emitted
+	AttributeEncodingPlain AttributeEncoding = "plain"
+	// AttributeEncodingBase64 decodes the attributes of every block
+	AttributeEncodingBase64 AttributeEncoding = "base64"
+	// AttributeEncodingAuto decodes the attributes of the blocks which attribute keys are all valid base64 encoded keys
+	AttributeEncodingAuto AttributeEncoding = "auto"
+)
+
+// attributeKeyRegex matches the decoded attribute keys, used to detect the base64 encoded attributes. Plain keys
+// either are not valid base64 (ex: `sender`, `amount`), or decode to binary data (ex: `receiver`).
+var attributeKeyRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.-]*$`)
+
+func ParseAttributeEncoding(value string) (AttributeEncoding, error) {
+	switch AttributeEncoding(value) {
+	case "", AttributeEncodingPlain:
+		return AttributeEncodingPlain, nil
+	case AttributeEncodingBase64, AttributeEncodingAuto:
+		return AttributeEncoding(value), nil
+	}
+	return "", fmt.Errorf("invalid attribute encoding %q, expecting one of %q, %q or %q", value, AttributeEncodingPlain, AttributeEncodingBase64, AttributeEncodingAuto)
+}
+
+// AttributeNormalizer decodes the base64 encoded event attributes of blocks, so that every block holds plain
+// attributes whatever the version of the chain that produced it
+type AttributeNormalizer struct {
+	encoding AttributeEncoding
+	// untilHeight is the last height which attributes can be encoded, usually the height of the chain upgrade to
+	// plain attributes, 0 when unknown
+	untilHeight uint64
+}
+
+func NewAttributeNormalizer(encoding AttributeEncoding, untilHeight uint64) *AttributeNormalizer {
+	return &AttributeNormalizer{
+		encoding:    encoding,
+		untilHeight: untilHeight,
+	}
+}
+
+func (n *AttributeNormalizer) String() string {
+	return fmt.Sprintf("encoding: %s, until height: %d", n.encoding, n.untilHeight)
+}
+
+// Normalize decodes the attributes of the block in place, returning whether they were encoded
+func (n *AttributeNormalizer) Normalize(block *pbcosmos.Block) (bool, error) {
+	if n.encoding == AttributeEncodingPlain || block.Header == nil {
+		return false, nil
+	}
+	if n.untilHeight != 0 && block.Header.Height > n.untilHeight {
+		return false, nil
+	}
+
+	var attributes []*pbcosmos.EventAttribute
+	collect := func(events []*pbcosmos.Event) {
+		for _, event := range events {
+			attributes = append(attributes, event.Attributes...)
+		}
+	}
+	if block.ResultBeginBlock != nil {
+		collect(block.ResultBeginBlock.Events)
+	}
+	for _, tx := range block.Transactions {
+		if tx.Result != nil {
+			collect(tx.Result.Events)
+		}
+	}
+	if block.ResultEndBlock != nil {
+		collect(block.ResultEndBlock.Events)
+	}
+
+	if len(attributes) == 0 {
+		return false, nil
+	}
+	if n.encoding == AttributeEncodingAuto && !encodedAttributes(attributes) {
+		return false, nil
+	}
+
+	// Attributes are decoded in a first pass and set in a second one, leaving the block untouched on error
+	keys := make([][]byte, len(attributes))
+	values := make([][]byte, len(attributes))
+	for i, attr := range attributes {
+		var err error
+		if keys[i], err = decodeAttribute(attr.Key); err != nil {
+			return false, fmt.Errorf("decoding attribute key %q of block %d: %w", attr.Key, block.Header.Height, err)
+		}
+		if values[i], err = decodeAttribute(attr.Value); err != nil {
+			return false, fmt.Errorf("decoding attribute %q value %q of block %d: %w", keys[i], attr.Value, block.Header.Height, err)
+		}
+	}
+	for i, attr := range attributes {
+		attr.Key = keys[i]
+		attr.Value = values[i]
+	}
+
+	return true, nil
+}
+
+// encodedAttributes returns whether all the attribute keys decode to valid keys
+func encodedAttributes(attributes []*pbcosmos.EventAttribute) bool {
+	for _, attr := range attributes {
+		key, err := decodeAttribute(attr.Key)
+		if err != nil || !attributeKeyRegex.Match(key) {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeAttribute(value []byte) ([]byte, error) {
+	out := make([]byte, base64.StdEncoding.DecodedLen(len(value)))
+	n, err := base64.StdEncoding.Decode(out, value)
+	if err != nil {
+		return nil, err
+	}
+	return out[:n], nil
+}
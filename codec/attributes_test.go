@@ -0,0 +1,93 @@
+package codec
+
+import (
+	"encoding/base64"
+	"testing"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func attributesBlock(height uint64, encode bool, keyValues ...string) *pbcosmos.Block {
+	event := &pbcosmos.Event{EventType: "transfer"}
+	for i := 0; i < len(keyValues); i += 2 {
+		key, value := []byte(keyValues[i]), []byte(keyValues[i+1])
+		if encode {
+			key = []byte(base64.StdEncoding.EncodeToString(key))
+			value = []byte(base64.StdEncoding.EncodeToString(value))
+		}
+		event.Attributes = append(event.Attributes, &pbcosmos.EventAttribute{Key: key, Value: value, Index: true})
+	}
+
+	return &pbcosmos.Block{
+		Header: &pbcosmos.Header{Height: height},
+		Transactions: []*pbcosmos.TxResult{
+			{Result: &pbcosmos.ResponseDeliverTx{Events: []*pbcosmos.Event{event}}},
+		},
+	}
+}
+
+func attributeStrings(block *pbcosmos.Block) (out []string) {
+	for _, attr := range block.Transactions[0].Result.Events[0].Attributes {
+		out = append(out, string(attr.Key), string(attr.Value))
+	}
+	return out
+}
+
+func TestAttributeNormalizer(t *testing.T) {
+	transfer := []string{"recipient", "cosmos1c4k24jzduc365kywrsvf5ujz4ya6mwympnc4en", "sender", "cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42", "amount", "2500uatom", "memo", ""}
+
+	tests := []struct {
+		name            string
+		encoding        AttributeEncoding
+		untilHeight     uint64
+		block           *pbcosmos.Block
+		expectedDecoded bool
+		expectedErr     bool
+	}{
+		{name: "plain encoding", encoding: AttributeEncodingPlain, block: attributesBlock(10, true, transfer...)},
+		{name: "base64 encoding", encoding: AttributeEncodingBase64, block: attributesBlock(10, true, transfer...), expectedDecoded: true},
+		{name: "base64 encoding of plain attributes", encoding: AttributeEncodingBase64, block: attributesBlock(10, false, transfer...), expectedErr: true},
+		{name: "auto encoded", encoding: AttributeEncodingAuto, block: attributesBlock(10, true, transfer...), expectedDecoded: true},
+		{name: "auto plain", encoding: AttributeEncodingAuto, block: attributesBlock(10, false, transfer...)},
+		// `receiver` and `spender` are valid base64, decoding to binary data
+		{name: "auto plain base64 keys", encoding: AttributeEncodingAuto, block: attributesBlock(10, false, "receiver", "cosmos1c4k24jzduc365kywrsvf5ujz4ya6mwympnc4en", "spender", "2500uatom")},
+		{name: "until height", encoding: AttributeEncodingBase64, untilHeight: 10, block: attributesBlock(10, true, transfer...), expectedDecoded: true},
+		{name: "above until height", encoding: AttributeEncodingBase64, untilHeight: 9, block: attributesBlock(10, true, transfer...)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			before := attributeStrings(test.block)
+
+			decoded, err := NewAttributeNormalizer(test.encoding, test.untilHeight).Normalize(test.block)
+			if test.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, before, attributeStrings(test.block))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedDecoded, decoded)
+
+			if test.expectedDecoded {
+				assert.Equal(t, transfer, attributeStrings(test.block))
+			} else {
+				assert.Equal(t, before, attributeStrings(test.block))
+			}
+		})
+	}
+}
+
+func TestParseAttributeEncoding(t *testing.T) {
+	encoding, err := ParseAttributeEncoding("")
+	require.NoError(t, err)
+	assert.Equal(t, AttributeEncodingPlain, encoding)
+
+	encoding, err = ParseAttributeEncoding("auto")
+	require.NoError(t, err)
+	assert.Equal(t, AttributeEncodingAuto, encoding)
+
+	_, err = ParseAttributeEncoding("hex")
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package codec
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// BlockVersionRaw is the dbin version of the blocks files holding proto-marshalled `bstream.Block` messages
+	BlockVersionRaw = 1
+	// BlockVersionZstd is the dbin version of the blocks files holding proto-marshalled `bstream.Block` messages,
+	// each one compressed with zstd
+	BlockVersionZstd = 2
+)
+
+// maxBlockSize bounds the size of the block messages read and decompressed, guarding against corrupted lengths and
+// decompression bombs
+const maxBlockSize = 1 << 30
+
+// BlockFormat is a version of the blocks files, defining how the proto-marshalled blocks are stored in the messages
+// of the dbin file
+type BlockFormat struct {
+	Version     int32
+	Description string
+	Encode      func(block []byte) []byte
+	Decode      func(message []byte) ([]byte, error)
+}
+
+var blockFormats = make(map[int32]*BlockFormat)
+
+// RegisterBlockFormat makes a version of the blocks files readable and writable, it panics when the version is
+// already registered
+func RegisterBlockFormat(format *BlockFormat) {
+	if _, found := blockFormats[format.Version]; found {
+		panic(fmt.Sprintf("blocks file version %d already registered", format.Version))
+	}
+	blockFormats[format.Version] = format
+}
+
+// GetBlockFormat returns the format of a version of the blocks files
+func GetBlockFormat(version int32) (*BlockFormat, bool) {
+	format, found := blockFormats[version]
+	return format, found
+}
+
+// BlockFormatVersions returns the registered versions of the blocks files, sorted
+func BlockFormatVersions() []int32 {
+	out := make([]int32, 0, len(blockFormats))
+	for version := range blockFormats {
+		out = append(out, version)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// zstdEncoder and zstdDecoder are only used through EncodeAll and DecodeAll, which can be called concurrently
+var (
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+)
+
+func init() {
+	var err error
+	if zstdEncoder, err = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1)); err != nil {
+		panic(err)
+	}
+	if zstdDecoder, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(maxBlockSize)); err != nil {
+		panic(err)
+	}
+
+	RegisterBlockFormat(&BlockFormat{
+		Version:     BlockVersionRaw,
+		Description: "raw blocks",
+		Encode:      func(block []byte) []byte { return block },
+		Decode:      func(message []byte) ([]byte, error) { return message, nil },
+	})
+	RegisterBlockFormat(&BlockFormat{
+		Version:     BlockVersionZstd,
+		Description: "zstd compressed blocks",
+		Encode: func(block []byte) []byte {
+			return zstdEncoder.EncodeAll(block, make([]byte, 0, len(block)/4))
+		},
+		Decode: func(message []byte) ([]byte, error) {
+			// A non-empty destination keeps the decoder from allocating the content size announced by the frame
+			// header upfront, which could be up to maxBlockSize for a corrupted message
+			return zstdDecoder.DecodeAll(message, make([]byte, 0, 4*len(message)))
+		},
+	})
+}
@@ -1,6 +1,9 @@
 package codec
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 
@@ -10,9 +13,59 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-// BlockReader reads the dbin format where each element is assumed to be a `bstream.Block`.
+var (
+	// ErrInvalidHeader is wrapped by the HeaderError of files not starting with a valid dbin header
+	ErrInvalidHeader = errors.New("invalid blocks file header")
+	// ErrUnsupportedContentType is wrapped by the HeaderError of files not holding Cosmos blocks
+	ErrUnsupportedContentType = errors.New("unsupported blocks file content type")
+	// ErrUnsupportedVersion is wrapped by the HeaderError of files which version is not registered
+	ErrUnsupportedVersion = errors.New("unsupported blocks file version")
+	// ErrCorruptedBlock is wrapped by the BlockError of truncated or undecodable blocks
+	ErrCorruptedBlock = errors.New("corrupted block")
+)
+
+// HeaderError is returned by NewBlockReader when the header of the file cannot be read or is not supported
+type HeaderError struct {
+	ContentType string
+	Version     int32
+	Err         error // ErrInvalidHeader, ErrUnsupportedContentType or ErrUnsupportedVersion
+	reason      string
+}
+
+func (e *HeaderError) Error() string {
+	switch e.Err {
+	case ErrUnsupportedContentType:
+		return fmt.Sprintf("%s %q, expecting %q (%s)", e.Err, e.ContentType, dbinContentType, pbbstream.Protocol_COSMOS)
+	case ErrUnsupportedVersion:
+		return fmt.Sprintf("%s %d, expecting one of %v", e.Err, e.Version, BlockFormatVersions())
+	}
+	return fmt.Sprintf("%s: %s", e.Err, e.reason)
+}
+
+func (e *HeaderError) Unwrap() error {
+	return e.Err
+}
+
+// BlockError is returned by BlockReader.Read when a block cannot be read, Index being its position in the file
+type BlockError struct {
+	Index int
+	Err   error
+}
+
+func (e *BlockError) Error() string {
+	return fmt.Sprintf("reading block at index %d: %s", e.Index, e.Err)
+}
+
+func (e *BlockError) Unwrap() error {
+	return e.Err
+}
+
+// BlockReader reads the dbin format where each element is assumed to be a `bstream.Block`, stored as defined by the
+// BlockFormat of the version of the file.
 type BlockReader struct {
-	src *dbin.Reader
+	src    io.Reader
+	format *BlockFormat
+	index  int
 }
 
 func NewBlockReader(reader io.Reader) (out *BlockReader, err error) {
@@ -20,42 +73,97 @@ func NewBlockReader(reader io.Reader) (out *BlockReader, err error) {
 
 	contentType, version, err := dbinReader.ReadHeader()
 	if err != nil {
-		return nil, fmt.Errorf("unable to read file header: %s", err)
+		return nil, &HeaderError{Err: ErrInvalidHeader, reason: err.Error()}
 	}
 
-	Protocol := pbbstream.Protocol(pbbstream.Protocol_value[contentType])
-	if Protocol != pbbstream.Protocol_COSMOS && version != 1 {
-		return nil, fmt.Errorf("reader only knows about %s block kind at version 1, got %s at version %d", Protocol, contentType, version)
+	if contentType != dbinContentType {
+		return nil, &HeaderError{ContentType: contentType, Version: version, Err: ErrUnsupportedContentType}
+	}
+
+	format, found := GetBlockFormat(version)
+	if !found {
+		return nil, &HeaderError{ContentType: contentType, Version: version, Err: ErrUnsupportedVersion}
 	}
 
 	return &BlockReader{
-		src: dbinReader,
+		src:    reader,
+		format: format,
 	}, nil
 }
 
+// Version returns the dbin version of the blocks file
+func (l *BlockReader) Version() int32 {
+	return l.format.Version
+}
+
+// Read returns the next block of the file, io.EOF once all of them were read
 func (l *BlockReader) Read() (*bstream.Block, error) {
-	message, err := l.src.ReadMessage()
+	message, err := l.readMessage()
+	if err != nil {
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, &BlockError{Index: l.index, Err: err}
+	}
+
+	blk, err := l.decodeBlock(message)
+	if err != nil {
+		return nil, &BlockError{Index: l.index, Err: fmt.Errorf("%w: %s", ErrCorruptedBlock, err)}
+	}
 
-	if len(message) > 0 {
-		pbBlock := new(pbbstream.Block)
+	l.index++
+	return blk, nil
+}
 
-		err = proto.Unmarshal(message, pbBlock)
-		if err != nil {
-			return nil, fmt.Errorf("unable to read block proto: %s", err)
+// readMessage reads the next length-prefixed dbin message, growing its buffer as bytes are read so that a corrupted
+// length does not allocate more than the size of the file
+func (l *BlockReader) readMessage() ([]byte, error) {
+	var lengthBytes [4]byte
+	n, err := io.ReadFull(l.src, lengthBytes[:])
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
 		}
+		if err == io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("%w: truncated message length, got %d of 4 bytes", ErrCorruptedBlock, n)
+		}
+		return nil, fmt.Errorf("reading message length: %w", err)
+	}
 
-		blk, err := bstream.NewBlockFromProto(pbBlock)
-		if err != nil {
-			return nil, err
+	length := int64(binary.BigEndian.Uint32(lengthBytes[:]))
+	if length == 0 {
+		return nil, fmt.Errorf("%w: empty message", ErrCorruptedBlock)
+	}
+	if length > maxBlockSize {
+		return nil, fmt.Errorf("%w: message length %d exceeds the maximum block size %d", ErrCorruptedBlock, length, maxBlockSize)
+	}
+
+	initialSize := length
+	if initialSize > bytes.MinRead*16 {
+		initialSize = bytes.MinRead * 16
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, initialSize))
+	read, err := io.CopyN(buf, l.src, length)
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("%w: truncated message, got %d of %d bytes", ErrCorruptedBlock, read, length)
 		}
+		return nil, fmt.Errorf("reading message: %w", err)
+	}
 
-		return blk, nil
+	return buf.Bytes(), nil
+}
+
+func (l *BlockReader) decodeBlock(message []byte) (*bstream.Block, error) {
+	payload, err := l.format.Decode(message)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode %s: %w", l.format.Description, err)
 	}
 
-	if err == io.EOF {
-		return nil, err
+	pbBlock := new(pbbstream.Block)
+	if err := proto.Unmarshal(payload, pbBlock); err != nil {
+		return nil, fmt.Errorf("unable to read block proto: %w", err)
 	}
 
-	// In all other cases, we are in an error path
-	return nil, fmt.Errorf("failed reading next dbin message: %s", err)
+	return bstream.NewBlockFromProto(pbBlock)
 }
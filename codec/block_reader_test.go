@@ -0,0 +1,224 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dbin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBlocks(t testing.TB, count int) (out []*bstream.Block) {
+	for i := 1; i <= count; i++ {
+		blk, err := FromProto(&pbcosmos.Block{
+			Header:       &pbcosmos.Header{Height: uint64(i), Hash: []byte{byte(i)}, Time: &pbcosmos.Timestamp{Seconds: 1663000000 + int64(i)}},
+			Transactions: []*pbcosmos.TxResult{{Result: &pbcosmos.ResponseDeliverTx{Log: string(bytes.Repeat([]byte("coin_received"), 100))}}},
+		})
+		require.NoError(t, err)
+		out = append(out, blk)
+	}
+	return out
+}
+
+func TestBlockReader_Versions(t *testing.T) {
+	for _, version := range []int{BlockVersionRaw, BlockVersionZstd} {
+		buf := new(bytes.Buffer)
+		writer, err := NewBlockWriter(buf, WithBlockVersion(version))
+		require.NoError(t, err)
+
+		blocks := testBlocks(t, 3)
+		for _, blk := range blocks {
+			require.NoError(t, writer.Write(blk))
+		}
+		size := buf.Len()
+
+		reader, err := NewBlockReader(buf)
+		require.NoError(t, err)
+		assert.Equal(t, int32(version), reader.Version())
+
+		for _, expected := range blocks {
+			blk, err := reader.Read()
+			require.NoError(t, err)
+			assert.Equal(t, expected.Number, blk.Number)
+			assert.Equal(t, expected.Id, blk.Id)
+
+			expectedPayload, err := expected.Payload.Get()
+			require.NoError(t, err)
+			payload, err := blk.Payload.Get()
+			require.NoError(t, err)
+			assert.Equal(t, expectedPayload, payload)
+		}
+		_, err = reader.Read()
+		assert.Equal(t, io.EOF, err)
+
+		if version == BlockVersionZstd {
+			assert.Less(t, size, 1000)
+		}
+	}
+}
+
+func TestBlockReader_Header(t *testing.T) {
+	header := func(contentType string, version int) []byte {
+		buf := new(bytes.Buffer)
+		require.NoError(t, dbin.NewWriter(buf).WriteHeader(contentType, version))
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name        string
+		data        []byte
+		expectedErr error
+	}{
+		{name: "empty", data: nil, expectedErr: ErrInvalidHeader},
+		{name: "truncated", data: []byte("dbin"), expectedErr: ErrInvalidHeader},
+		{name: "bad magic", data: []byte("dbix\x01CSM01"), expectedErr: ErrInvalidHeader},
+		{name: "bad version digits", data: []byte("dbin\x01CSMv1"), expectedErr: ErrInvalidHeader},
+		{name: "other protocol", data: header("ETH", 1), expectedErr: ErrUnsupportedContentType},
+		{name: "unknown version", data: header("CSM", 3), expectedErr: ErrUnsupportedVersion},
+		{name: "raw", data: header("CSM", 1)},
+		{name: "zstd", data: header("CSM", 2)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewBlockReader(bytes.NewReader(test.data))
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, test.expectedErr)
+			var headerErr *HeaderError
+			require.ErrorAs(t, err, &headerErr)
+		})
+	}
+}
+
+func TestBlockReader_CorruptedBlocks(t *testing.T) {
+	file := func(version int) []byte {
+		buf := new(bytes.Buffer)
+		writer, err := NewBlockWriter(buf, WithBlockVersion(version))
+		require.NoError(t, err)
+		for _, blk := range testBlocks(t, 2) {
+			require.NoError(t, writer.Write(blk))
+		}
+		return buf.Bytes()
+	}
+
+	// 10 bytes header followed by the 4 bytes length of the first message
+	firstMessageLength := int(binary.BigEndian.Uint32(file(BlockVersionRaw)[10:14]))
+
+	tests := []struct {
+		name          string
+		data          []byte
+		expectedIndex int
+	}{
+		{name: "truncated length", data: file(BlockVersionRaw)[:10+4+firstMessageLength+2], expectedIndex: 1},
+		{name: "truncated message", data: file(BlockVersionZstd)[:len(file(BlockVersionZstd))-1], expectedIndex: 1},
+		{name: "huge length", data: append(file(BlockVersionRaw), 0xff, 0xff, 0xff, 0xff, 0x01), expectedIndex: 2},
+		{name: "empty message", data: append(file(BlockVersionRaw), 0, 0, 0, 0), expectedIndex: 2},
+		{name: "invalid zstd", data: append(file(BlockVersionZstd), 0, 0, 0, 2, 0x28, 0xb5), expectedIndex: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reader, err := NewBlockReader(bytes.NewReader(test.data))
+			require.NoError(t, err)
+
+			for {
+				_, err = reader.Read()
+				if err != nil {
+					break
+				}
+			}
+
+			require.ErrorIs(t, err, ErrCorruptedBlock)
+			var blockErr *BlockError
+			require.ErrorAs(t, err, &blockErr)
+			assert.Equal(t, test.expectedIndex, blockErr.Index)
+		})
+	}
+}
+
+// checkBlockReaderErrors reads the whole file, failing on any other error than the typed ones
+func checkBlockReaderErrors(t *testing.T, data []byte) {
+	reader, err := NewBlockReader(bytes.NewReader(data))
+	if err != nil {
+		var headerErr *HeaderError
+		require.ErrorAs(t, err, &headerErr)
+		return
+	}
+
+	for i := 0; ; i++ {
+		_, err := reader.Read()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			require.ErrorIs(t, err, ErrCorruptedBlock)
+			return
+		}
+		require.Less(t, i, len(data))
+	}
+}
+
+func FuzzBlockReader_Header(f *testing.F) {
+	for _, version := range []int{BlockVersionRaw, BlockVersionZstd} {
+		buf := new(bytes.Buffer)
+		writer, err := NewBlockWriter(buf, WithBlockVersion(version))
+		require.NoError(f, err)
+		for _, blk := range testBlocks(f, 1) {
+			require.NoError(f, writer.Write(blk))
+		}
+		f.Add(buf.Bytes()[:10])
+	}
+	f.Add([]byte("dbin\x01CSM99"))
+	f.Add([]byte("dbin\x01ETH01"))
+
+	f.Fuzz(func(t *testing.T, header []byte) {
+		checkBlockReaderErrors(t, header)
+	})
+}
+
+func FuzzBlockReader_Messages(f *testing.F) {
+	for _, version := range []int{BlockVersionRaw, BlockVersionZstd} {
+		buf := new(bytes.Buffer)
+		writer, err := NewBlockWriter(buf, WithBlockVersion(version))
+		require.NoError(f, err)
+		for _, blk := range testBlocks(f, 2) {
+			require.NoError(f, writer.Write(blk))
+		}
+		f.Add(version, buf.Bytes()[10:])
+		f.Add(version, buf.Bytes()[10:len(buf.Bytes())/2])
+	}
+
+	f.Fuzz(func(t *testing.T, version int, messages []byte) {
+		header := new(bytes.Buffer)
+		if err := dbin.NewWriter(header).WriteHeader(dbinContentType, 1+(version&1)); err != nil {
+			t.Skip()
+		}
+		checkBlockReaderErrors(t, append(header.Bytes(), messages...))
+	})
+}
+
+func TestBlockWriter_Version(t *testing.T) {
+	_, err := NewBlockWriter(new(bytes.Buffer), WithBlockVersion(3))
+	assert.Error(t, err)
+
+	assert.Error(t, SetBlockWriterVersion(0))
+	require.NoError(t, SetBlockWriterVersion(BlockVersionZstd))
+	defer SetBlockWriterVersion(BlockVersionRaw)
+
+	buf := new(bytes.Buffer)
+	_, err = NewBlockWriter(buf)
+	require.NoError(t, err)
+
+	reader, err := NewBlockReader(buf)
+	require.NoError(t, err)
+	assert.Equal(t, int32(BlockVersionZstd), reader.Version())
+}
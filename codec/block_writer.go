@@ -13,20 +13,57 @@ const (
 	dbinContentType = "CSM"
 )
 
+// blockWriterVersion is the version of the blocks files written through the bstream block writer factory
+var blockWriterVersion = BlockVersionRaw
+
+// SetBlockWriterVersion sets the version of the blocks files written by the merger, the reader and the tools
+func SetBlockWriterVersion(version int) error {
+	if _, found := GetBlockFormat(int32(version)); !found {
+		return fmt.Errorf("unsupported blocks file version %d, expecting one of %v", version, BlockFormatVersions())
+	}
+	blockWriterVersion = version
+	return nil
+}
+
 type BlockWriter struct {
-	src *dbin.Writer
+	src    *dbin.Writer
+	format *BlockFormat
+}
+
+type blockWriterOptions struct {
+	version int
+}
+
+type BlockWriterOption func(*blockWriterOptions)
+
+// WithBlockVersion writes the blocks file at version instead of the one set with SetBlockWriterVersion
+func WithBlockVersion(version int) BlockWriterOption {
+	return func(o *blockWriterOptions) {
+		o.version = version
+	}
 }
 
-func NewBlockWriter(writer io.Writer) (*BlockWriter, error) {
+func NewBlockWriter(writer io.Writer, opts ...BlockWriterOption) (*BlockWriter, error) {
+	options := &blockWriterOptions{version: blockWriterVersion}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	format, found := GetBlockFormat(int32(options.version))
+	if !found {
+		return nil, fmt.Errorf("unsupported blocks file version %d, expecting one of %v", options.version, BlockFormatVersions())
+	}
+
 	dbinWriter := dbin.NewWriter(writer)
 
-	err := dbinWriter.WriteHeader(dbinContentType, 1)
+	err := dbinWriter.WriteHeader(dbinContentType, options.version)
 	if err != nil {
 		return nil, fmt.Errorf("unable to write file header: %s", err)
 	}
 
 	return &BlockWriter{
-		src: dbinWriter,
+		src:    dbinWriter,
+		format: format,
 	}, nil
 }
 
@@ -41,5 +78,5 @@ func (w *BlockWriter) Write(block *bstream.Block) error {
 		return fmt.Errorf("unable to marshal proto block: %s", err)
 	}
 
-	return w.src.WriteMessage(bytes)
+	return w.src.WriteMessage(w.format.Encode(bytes))
 }
@@ -13,6 +13,9 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// MergedBundleSize is the number of blocks of each merged blocks file, named after the number of its first block
+const MergedBundleSize = uint64(100)
+
 func init() {
 	bstream.GetBlockReaderFactory = bstream.BlockReaderFactoryFunc(blockReaderFactory)
 	bstream.GetBlockDecoder = bstream.BlockDecoderFunc(blockDecoder)
@@ -21,14 +21,29 @@ type ConsoleReader struct {
 
 	height uint64
 	block  *pbcosmos.Block
+
+	attributeNormalizer *AttributeNormalizer
+}
+
+type ConsoleReaderOption func(*ConsoleReader)
+
+// WithAttributeNormalizer decodes the base64 encoded event attributes of the blocks read
+func WithAttributeNormalizer(normalizer *AttributeNormalizer) ConsoleReaderOption {
+	return func(cr *ConsoleReader) {
+		cr.attributeNormalizer = normalizer
+	}
 }
 
-func NewConsoleReader(lines chan string, logger *zap.Logger) (*ConsoleReader, error) {
-	return &ConsoleReader{
+func NewConsoleReader(lines chan string, logger *zap.Logger, opts ...ConsoleReaderOption) (*ConsoleReader, error) {
+	cr := &ConsoleReader{
 		lines:  lines,
 		logger: logger,
 		done:   make(chan interface{}),
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(cr)
+	}
+	return cr, nil
 }
 
 func (cr *ConsoleReader) Done() <-chan interface{} {
@@ -48,6 +63,12 @@ func (cr *ConsoleReader) ReadBlock() (out *bstream.Block, err error) {
 	}
 
 	pbBlock := v.(*pbcosmos.Block)
+	if cr.attributeNormalizer != nil {
+		if _, err := cr.attributeNormalizer.Normalize(pbBlock); err != nil {
+			return nil, err
+		}
+	}
+
 	blk, err := FromProto(pbBlock)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,271 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatParquet Format = "parquet"
+	// FormatJSONL writes one JSON object per row, times being RFC3339 formatted and the JSON of the messages embedded
+	// as is
+	FormatJSONL Format = "jsonl"
+	// FormatCSV writes a header line followed by one line per row, times being RFC3339 formatted and the null values
+	// empty
+	FormatCSV Format = "csv"
+)
+
+var Formats = []Format{FormatParquet, FormatJSONL, FormatCSV}
+
+func ParseFormat(value string) (Format, error) {
+	for _, format := range Formats {
+		if Format(value) == format {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("invalid format %q, expecting one of %v", value, Formats)
+}
+
+// Extension returns the extension of the files of the format
+func (f Format) Extension() string {
+	return string(f)
+}
+
+// column is a column of a table, named after the parquet tag of the field of the row
+type column struct {
+	name      string
+	field     int
+	timestamp bool // milliseconds since the epoch
+	rawJSON   bool // holds JSON, embedded as is by FormatJSONL
+}
+
+var tableColumns = map[Table][]column{
+	BlocksTable:       rowColumns(Block{}),
+	TransactionsTable: rowColumns(Transaction{}),
+	MessagesTable:     rowColumns(Message{}),
+	EventsTable:       rowColumns(Event{}),
+	AttributesTable:   rowColumns(Attribute{}),
+}
+
+func rowColumns(row interface{}) []column {
+	rowType := reflect.TypeOf(row)
+	out := make([]column, 0, rowType.NumField())
+	for i := 0; i < rowType.NumField(); i++ {
+		col := column{field: i}
+		for _, option := range strings.Split(rowType.Field(i).Tag.Get("parquet"), ",") {
+			key, value, _ := strings.Cut(strings.TrimSpace(option), "=")
+			switch {
+			case key == "name":
+				col.name = value
+			case key == "convertedtype" && value == "TIMESTAMP_MILLIS":
+				col.timestamp = true
+			}
+		}
+		col.rawJSON = rowType.Field(i).Tag.Get("export") == "json"
+		out = append(out, col)
+	}
+	return out
+}
+
+// Columns returns the names of the columns of a table
+func Columns(table Table) []string {
+	cols := tableColumns[table]
+	out := make([]string, len(cols))
+	for i, col := range cols {
+		out[i] = col.name
+	}
+	return out
+}
+
+// selectColumns returns the columns of a table matching names, in the order of names, all the columns when empty
+func selectColumns(table Table, names []string) ([]column, error) {
+	cols, found := tableColumns[table]
+	if !found {
+		return nil, fmt.Errorf("unknown table %q", table)
+	}
+	if len(names) == 0 {
+		return cols, nil
+	}
+
+	out := make([]column, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, col := range cols {
+			if col.name == strings.TrimSpace(name) {
+				out = append(out, col)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column %q of table %s, valid values are %v", name, table, Columns(table))
+		}
+	}
+	return out, nil
+}
+
+// ValidateColumns checks that the columns exist in the table
+func ValidateColumns(table Table, names []string) error {
+	_, err := selectColumns(table, names)
+	return err
+}
+
+// tableRows returns the rows of a table as pointers to row structs, along with the struct used as schema
+func tableRows(table Table, rows *Rows) (schema interface{}, values []interface{}, err error) {
+	switch table {
+	case BlocksTable:
+		schema = new(Block)
+		for _, row := range rows.Blocks {
+			values = append(values, row)
+		}
+	case TransactionsTable:
+		schema = new(Transaction)
+		for _, row := range rows.Transactions {
+			values = append(values, row)
+		}
+	case MessagesTable:
+		schema = new(Message)
+		for _, row := range rows.Messages {
+			values = append(values, row)
+		}
+	case EventsTable:
+		schema = new(Event)
+		for _, row := range rows.Events {
+			values = append(values, row)
+		}
+	case AttributesTable:
+		schema = new(Attribute)
+		for _, row := range rows.Attributes {
+			values = append(values, row)
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown table %q", table)
+	}
+	return schema, values, nil
+}
+
+// columnValue returns the value of a column of a row, nil for the null values
+func columnValue(row interface{}, col column) interface{} {
+	value := reflect.ValueOf(row).Elem().Field(col.field)
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if col.timestamp {
+		return time.UnixMilli(value.Int()).UTC().Format(time.RFC3339Nano)
+	}
+	return value.Interface()
+}
+
+// WriteJSONL writes the rows of a table as newline-delimited JSON objects holding the columns, all of them when
+// columns is empty
+func WriteJSONL(w io.Writer, table Table, rows *Rows, columns []string) error {
+	cols, err := selectColumns(table, columns)
+	if err != nil {
+		return err
+	}
+	_, values, err := tableRows(table, rows)
+	if err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	for _, row := range values {
+		buf.Reset()
+		buf.WriteByte('{')
+		for i, col := range cols {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			key, _ := json.Marshal(col.name)
+			buf.Write(key)
+			buf.WriteByte(':')
+
+			value := columnValue(row, col)
+			if raw, ok := value.(string); ok && col.rawJSON && json.Valid([]byte(raw)) {
+				buf.WriteString(raw)
+				continue
+			}
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("encoding %s column %q: %w", table, col.name, err)
+			}
+			buf.Write(encoded)
+		}
+		buf.WriteString("}\n")
+
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteCSV writes the rows of a table as CSV lines holding the columns, all of them when columns is empty, preceded
+// by a header line when header is set
+func WriteCSV(w io.Writer, table Table, rows *Rows, columns []string, header bool) error {
+	cols, err := selectColumns(table, columns)
+	if err != nil {
+		return err
+	}
+	_, values, err := tableRows(table, rows)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	record := make([]string, len(cols))
+	if header {
+		for i, col := range cols {
+			record[i] = col.name
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range values {
+		for i, col := range cols {
+			switch value := columnValue(row, col).(type) {
+			case nil:
+				record[i] = ""
+			case string:
+				record[i] = value
+			case bool:
+				record[i] = strconv.FormatBool(value)
+			default:
+				record[i] = fmt.Sprintf("%d", value)
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// Write writes the rows of a table in a format, columns being ignored by FormatParquet which writes all of them
+func Write(w io.Writer, format Format, table Table, rows *Rows, columns []string) error {
+	switch format {
+	case FormatParquet:
+		return WriteParquet(w, table, rows)
+	case FormatJSONL:
+		return WriteJSONL(w, table, rows, columns)
+	case FormatCSV:
+		return WriteCSV(w, table, rows, columns, true)
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
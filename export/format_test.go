@@ -0,0 +1,66 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumns(t *testing.T) {
+	assert.Equal(t, []string{"id", "block_height", "block_time", "origin", "tx_index", "tx_hash", "msg_index", "index", "type"}, Columns(EventsTable))
+
+	require.NoError(t, ValidateColumns(TransactionsTable, []string{"hash", "gas_used"}))
+	require.Error(t, ValidateColumns(TransactionsTable, []string{"hash", "gas"}))
+}
+
+func TestWriteJSONL(t *testing.T) {
+	rows := &Rows{}
+	require.NoError(t, rows.Append(testBlock()))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, WriteJSONL(buf, EventsTable, rows, nil))
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 4)
+	assert.JSONEq(t, `{"id":"12000004/BeginBlock/0/0","block_height":12000004,"block_time":"2022-09-12T16:26:40.25Z","origin":"BeginBlock","tx_index":null,"tx_hash":null,"msg_index":null,"index":0,"type":"mint"}`, lines[0])
+	assert.JSONEq(t, `{"id":"12000004/DeliverTx/0/1","block_height":12000004,"block_time":"2022-09-12T16:26:40.25Z","origin":"DeliverTx","tx_index":0,"tx_hash":"AA","msg_index":0,"index":1,"type":"transfer"}`, lines[2])
+
+	// The message JSON is embedded, the columns are written in the requested order
+	buf.Reset()
+	require.NoError(t, WriteJSONL(buf, MessagesTable, rows, []string{"type_url", "json"}))
+	assert.Equal(t, `{"type_url":"/cosmos.bank.v1beta1.MsgSend","json":{"1":"cosmos1from","2":"cosmos1to","3":{"1":"uatom","2":"1000"}}}`+"\n", buf.String())
+}
+
+func TestWriteCSV(t *testing.T) {
+	rows := &Rows{}
+	require.NoError(t, rows.Append(testBlock()))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, WriteCSV(buf, EventsTable, rows, []string{"id", "tx_hash", "msg_index", "type"}, true))
+	assert.Equal(t, strings.Join([]string{
+		"id,tx_hash,msg_index,type",
+		"12000004/BeginBlock/0/0,,,mint",
+		"12000004/DeliverTx/0/0,AA,,tx",
+		"12000004/DeliverTx/0/1,AA,0,transfer",
+		"12000004/EndBlock/0/0,,,complete_unbonding",
+	}, "\n")+"\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteCSV(buf, TransactionsTable, rows, []string{"block_time", "fee", "memo"}, false))
+	assert.Equal(t, `2022-09-12T16:26:40.25Z,"500uatom,1uosmo",thanks`+"\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteCSV(buf, AttributesTable, &Rows{}, []string{"key", "indexed"}, true))
+	assert.Equal(t, "key,indexed\n", buf.String())
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("csv")
+	require.NoError(t, err)
+	assert.Equal(t, "csv", format.Extension())
+
+	_, err = ParseFormat("xml")
+	require.Error(t, err)
+}
@@ -0,0 +1,138 @@
+package export
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// maxMessageDepth bounds the nesting of the messages decoded without their definition
+const maxMessageDepth = 32
+
+// MessageJSON formats a transaction message as JSON. The messages which type is registered in the protobuf registry
+// are formatted with protojson, the other ones (most Cosmos SDK messages, which definitions are not part of this
+// binary) are decoded from their wire format into an object keyed by field number, ex: a `MsgSend` becomes
+// `{"1":"cosmos1...","2":"cosmos1...","3":{"1":"uatom","2":"1000"}}`. Length-delimited fields are printable
+// strings, nested messages or base64 encoded bytes, the fields present more than once are arrays (a repeated field
+// holding a single value cannot be told apart from a singular one without the definition of the message).
+func MessageJSON(message *anypb.Any) (string, error) {
+	if messageType, err := protoregistry.GlobalTypes.FindMessageByURL(message.TypeUrl); err == nil {
+		msg := messageType.New().Interface()
+		if err := message.UnmarshalTo(msg); err == nil {
+			out, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+			if err != nil {
+				return "", fmt.Errorf("formatting %s: %w", message.TypeUrl, err)
+			}
+			return string(out), nil
+		}
+	}
+
+	fields, ok := decodeWireMessage(message.Value, 0)
+	if !ok {
+		return "", fmt.Errorf("decoding %s: invalid protobuf message", message.TypeUrl)
+	}
+	out, err := json.Marshal(fields)
+	if err != nil {
+		return "", fmt.Errorf("formatting %s: %w", message.TypeUrl, err)
+	}
+	return string(out), nil
+}
+
+// RawMessageJSON formats the bytes of a transaction message as JSON, ex: `{"@bytes":"CgJhYg=="}`. It is used for the
+// messages which MessageJSON cannot decode.
+func RawMessageJSON(message *anypb.Any) string {
+	return fmt.Sprintf(`{"@bytes":%q}`, base64.StdEncoding.EncodeToString(message.Value))
+}
+
+// decodeWireMessage decodes the fields of a message without its definition, returning false when data is not a
+// valid message
+func decodeWireMessage(data []byte, depth int) (map[string]interface{}, bool) {
+	out := make(map[string]interface{})
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, false
+		}
+		data = data[n:]
+
+		var value interface{}
+		switch typ {
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(data)
+			if n < 0 {
+				return nil, false
+			}
+			value, data = v, data[n:]
+		case protowire.Fixed32Type:
+			v, n := protowire.ConsumeFixed32(data)
+			if n < 0 {
+				return nil, false
+			}
+			value, data = v, data[n:]
+		case protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(data)
+			if n < 0 {
+				return nil, false
+			}
+			value, data = v, data[n:]
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return nil, false
+			}
+			value, data = decodeWireBytes(v, depth), data[n:]
+		default:
+			// Groups are deprecated and not used by Cosmos messages
+			return nil, false
+		}
+
+		key := strconv.Itoa(int(num))
+		switch existing := out[key].(type) {
+		case nil:
+			out[key] = value
+		case []interface{}:
+			out[key] = append(existing, value)
+		default:
+			out[key] = []interface{}{existing, value}
+		}
+	}
+	return out, true
+}
+
+// decodeWireBytes decodes a length-delimited field, which can be a string, a nested message or bytes. The encoding
+// of messages is ambiguous, a string starting with a control character is assumed to be a nested message, as the
+// tags of the first fields are (ex: `\n` for field 1).
+func decodeWireBytes(data []byte, depth int) interface{} {
+	if printable(data) {
+		return string(data)
+	}
+	if depth < maxMessageDepth {
+		if fields, ok := decodeWireMessage(data, depth+1); ok && len(fields) > 0 {
+			return fields
+		}
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func printable(data []byte) bool {
+	if len(data) > 0 && data[0] < ' ' {
+		return false
+	}
+	if !utf8.Valid(data) {
+		return false
+	}
+	for _, r := range string(data) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
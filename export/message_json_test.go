@@ -0,0 +1,99 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func coin(denom, amount string) []byte {
+	var out []byte
+	out = protowire.AppendTag(out, 1, protowire.BytesType)
+	out = protowire.AppendString(out, denom)
+	out = protowire.AppendTag(out, 2, protowire.BytesType)
+	out = protowire.AppendString(out, amount)
+	return out
+}
+
+// msgSend encodes a `/cosmos.bank.v1beta1.MsgSend`, which definition is not registered
+func msgSend(from, to string, coins ...[]byte) *anypb.Any {
+	var value []byte
+	value = protowire.AppendTag(value, 1, protowire.BytesType)
+	value = protowire.AppendString(value, from)
+	value = protowire.AppendTag(value, 2, protowire.BytesType)
+	value = protowire.AppendString(value, to)
+	for _, c := range coins {
+		value = protowire.AppendTag(value, 3, protowire.BytesType)
+		value = protowire.AppendBytes(value, c)
+	}
+	return &anypb.Any{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: value}
+}
+
+func TestMessageJSON(t *testing.T) {
+	registered, err := anypb.New(durationpb.New(1500000000))
+	require.NoError(t, err)
+
+	var scalars []byte
+	scalars = protowire.AppendTag(scalars, 1, protowire.VarintType)
+	scalars = protowire.AppendVarint(scalars, 42)
+	scalars = protowire.AppendTag(scalars, 2, protowire.BytesType)
+	scalars = protowire.AppendBytes(scalars, []byte{0xff, 0x00, 0x01})
+	scalars = protowire.AppendTag(scalars, 3, protowire.Fixed64Type)
+	scalars = protowire.AppendFixed64(scalars, 7)
+	scalars = protowire.AppendTag(scalars, 4, protowire.BytesType)
+	scalars = protowire.AppendString(scalars, `{"transfer":{"amount":"1"}}`)
+
+	tests := []struct {
+		name        string
+		message     *anypb.Any
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "registered type",
+			message:  registered,
+			expected: `"1.500s"`,
+		},
+		{
+			name:     "nested message",
+			message:  msgSend("cosmos1from", "cosmos1to", coin("uatom", "1000")),
+			expected: `{"1":"cosmos1from","2":"cosmos1to","3":{"1":"uatom","2":"1000"}}`,
+		},
+		{
+			name:     "repeated field",
+			message:  msgSend("cosmos1from", "cosmos1to", coin("uatom", "1000"), coin("uosmo", "5")),
+			expected: `{"1":"cosmos1from","2":"cosmos1to","3":[{"1":"uatom","2":"1000"},{"1":"uosmo","2":"5"}]}`,
+		},
+		{
+			name:     "scalars, bytes and json string",
+			message:  &anypb.Any{TypeUrl: "/cosmwasm.wasm.v1.MsgExecuteContract", Value: scalars},
+			expected: `{"1":42,"2":"/wAB","3":7,"4":"{\"transfer\":{\"amount\":\"1\"}}"}`,
+		},
+		{
+			name:     "empty message",
+			message:  &anypb.Any{TypeUrl: "/cosmos.crisis.v1beta1.MsgEmpty"},
+			expected: `{}`,
+		},
+		{
+			name:        "invalid message",
+			message:     &anypb.Any{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{0x0a, 0x10, 'a'}},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := MessageJSON(test.message)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expected, out)
+		})
+	}
+}
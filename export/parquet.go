@@ -0,0 +1,38 @@
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// parquetRowGroupSize is the size of the row groups of the parquet files, a partition usually fitting in one
+const parquetRowGroupSize = 128 * 1024 * 1024
+
+// WriteParquet writes the rows of a table as a snappy compressed parquet file, an empty table being written as a
+// file holding the schema only
+func WriteParquet(w io.Writer, table Table, rows *Rows) error {
+	schema, values, err := tableRows(table, rows)
+	if err != nil {
+		return err
+	}
+
+	pw, err := writer.NewParquetWriterFromWriter(w, schema, 1)
+	if err != nil {
+		return fmt.Errorf("creating %s parquet writer: %w", table, err)
+	}
+	pw.RowGroupSize = parquetRowGroupSize
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	for _, value := range values {
+		if err := pw.Write(value); err != nil {
+			return fmt.Errorf("writing %s row: %w", table, err)
+		}
+	}
+	if err := pw.WriteStop(); err != nil {
+		return fmt.Errorf("writing %s parquet footer: %w", table, err)
+	}
+	return nil
+}
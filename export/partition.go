@@ -0,0 +1,50 @@
+package export
+
+import (
+	"fmt"
+)
+
+// Partition is a range of blocks exported to one file per table, aligned on the partition size except at the
+// boundaries of the exported range
+type Partition struct {
+	Start uint64
+	Stop  uint64 // exclusive
+}
+
+// Partitions splits [start, stop) in partitions aligned on size
+func Partitions(start, stop, size uint64) ([]Partition, error) {
+	if size == 0 {
+		return nil, fmt.Errorf("partition size must be greater than 0")
+	}
+	if stop <= start {
+		return nil, fmt.Errorf("stop block %d must be greater than start block %d", stop, start)
+	}
+
+	var out []Partition
+	for partStart := start - start%size; partStart < stop; partStart += size {
+		partition := Partition{Start: partStart, Stop: partStart + size}
+		if partition.Start < start {
+			partition.Start = start
+		}
+		if partition.Stop > stop {
+			partition.Stop = stop
+		}
+		out = append(out, partition)
+	}
+	return out, nil
+}
+
+// Contains returns whether the block is part of the partition
+func (p Partition) Contains(blockNum uint64) bool {
+	return blockNum >= p.Start && blockNum < p.Stop
+}
+
+// Filename returns the name of the file of a table for the partition, relative to the export store, ex:
+// `events/0000010000-0000019999.parquet`
+func (p Partition) Filename(table Table, extension string) string {
+	return fmt.Sprintf("%s/%010d-%010d.%s", table, p.Start, p.Stop-1, extension)
+}
+
+func (p Partition) String() string {
+	return fmt.Sprintf("[%d, %d)", p.Start, p.Stop)
+}
@@ -0,0 +1,43 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitions(t *testing.T) {
+	tests := []struct {
+		name        string
+		start, stop uint64
+		size        uint64
+		expected    []Partition
+		expectedErr bool
+	}{
+		{name: "aligned", start: 0, stop: 300, size: 100, expected: []Partition{{0, 100}, {100, 200}, {200, 300}}},
+		{name: "unaligned boundaries", start: 150, stop: 320, size: 100, expected: []Partition{{150, 200}, {200, 300}, {300, 320}}},
+		{name: "within one partition", start: 110, stop: 120, size: 100, expected: []Partition{{110, 120}}},
+		{name: "empty range", start: 100, stop: 100, size: 100, expectedErr: true},
+		{name: "zero size", start: 0, stop: 100, size: 0, expectedErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			partitions, err := Partitions(test.start, test.stop, test.size)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, partitions)
+		})
+	}
+}
+
+func TestPartition_Filename(t *testing.T) {
+	partition := Partition{Start: 10000, Stop: 20000}
+	assert.Equal(t, "events/0000010000-0000019999.parquet", partition.Filename(EventsTable, "parquet"))
+	assert.True(t, partition.Contains(19999))
+	assert.False(t, partition.Contains(20000))
+}
@@ -0,0 +1,269 @@
+// Package export flattens Cosmos blocks into the rows of the blocks, transactions, messages, events and attributes
+// tables, written as parquet, JSONL or CSV files by `tools export`
+package export
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/graphprotocol/firehose-cosmos/transform"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+type Table string
+
+const (
+	BlocksTable       Table = "blocks"
+	TransactionsTable Table = "transactions"
+	MessagesTable     Table = "messages"
+	EventsTable       Table = "events"
+	AttributesTable   Table = "attributes"
+)
+
+// Tables lists all the tables, in the order they are written
+var Tables = []Table{BlocksTable, TransactionsTable, MessagesTable, EventsTable, AttributesTable}
+
+func ParseTables(names []string) ([]Table, error) {
+	known := make(map[Table]bool)
+	for _, table := range Tables {
+		known[table] = true
+	}
+
+	var out []Table
+	seen := make(map[Table]bool)
+	for _, name := range names {
+		table := Table(strings.TrimSpace(name))
+		if !known[table] {
+			return nil, fmt.Errorf("unknown table %q, valid values are %v", name, Tables)
+		}
+		if seen[table] {
+			continue
+		}
+		seen[table] = true
+		out = append(out, table)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("at least one table is required, valid values are %v", Tables)
+	}
+	return out, nil
+}
+
+// Block is a row of the blocks table. Hashes and addresses are upper case hex encoded, times are milliseconds since
+// the epoch.
+type Block struct {
+	Height          int64  `parquet:"name=height, type=INT64"`
+	Hash            string `parquet:"name=hash, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Time            int64  `parquet:"name=time, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	ChainID         string `parquet:"name=chain_id, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	ProposerAddress string `parquet:"name=proposer_address, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	TxCount         int32  `parquet:"name=tx_count, type=INT32"`
+	EventCount      int32  `parquet:"name=event_count, type=INT32"`
+}
+
+// Transaction is a row of the transactions table, the fee being formatted as Cosmos SDK coins (ex: `100uatom,5uosmo`)
+type Transaction struct {
+	BlockHeight  int64  `parquet:"name=block_height, type=INT64"`
+	BlockTime    int64  `parquet:"name=block_time, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	Index        int32  `parquet:"name=index, type=INT32"`
+	Hash         string `parquet:"name=hash, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Code         int64  `parquet:"name=code, type=INT64"`
+	Codespace    string `parquet:"name=codespace, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	GasWanted    int64  `parquet:"name=gas_wanted, type=INT64"`
+	GasUsed      int64  `parquet:"name=gas_used, type=INT64"`
+	GasLimit     int64  `parquet:"name=gas_limit, type=INT64"`
+	Fee          string `parquet:"name=fee, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Payer        string `parquet:"name=payer, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Granter      string `parquet:"name=granter, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Memo         string `parquet:"name=memo, type=BYTE_ARRAY, convertedtype=UTF8"`
+	MessageCount int32  `parquet:"name=message_count, type=INT32"`
+	EventCount   int32  `parquet:"name=event_count, type=INT32"`
+}
+
+// Message is a row of the messages table, see MessageJSON for the format of the JSON column. The messages which
+// cannot be formatted hold their bytes, see RawMessageJSON.
+type Message struct {
+	BlockHeight int64  `parquet:"name=block_height, type=INT64"`
+	BlockTime   int64  `parquet:"name=block_time, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	TxIndex     int32  `parquet:"name=tx_index, type=INT32"`
+	TxHash      string `parquet:"name=tx_hash, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Index       int32  `parquet:"name=index, type=INT32"`
+	TypeURL     string `parquet:"name=type_url, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	JSON        string `parquet:"name=json, type=BYTE_ARRAY, convertedtype=UTF8" export:"json"`
+}
+
+// Event is a row of the events table, identified by the stable ID of the EventsOnly transform. The transaction
+// columns are null for the begin and end blocker events, the message index is null for the events which are not
+// emitted by a message.
+type Event struct {
+	ID          string  `parquet:"name=id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	BlockHeight int64   `parquet:"name=block_height, type=INT64"`
+	BlockTime   int64   `parquet:"name=block_time, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	Origin      string  `parquet:"name=origin, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	TxIndex     *int32  `parquet:"name=tx_index, type=INT32, repetitiontype=OPTIONAL"`
+	TxHash      *string `parquet:"name=tx_hash, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	MsgIndex    *int32  `parquet:"name=msg_index, type=INT32, repetitiontype=OPTIONAL"`
+	Index       int32   `parquet:"name=index, type=INT32"`
+	Type        string  `parquet:"name=type, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+}
+
+// Attribute is a row of the attributes table, referencing its event by ID
+type Attribute struct {
+	EventID     string `parquet:"name=event_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	BlockHeight int64  `parquet:"name=block_height, type=INT64"`
+	EventType   string `parquet:"name=event_type, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Index       int32  `parquet:"name=index, type=INT32"`
+	Key         string `parquet:"name=key, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Value       string `parquet:"name=value, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Indexed     bool   `parquet:"name=indexed, type=BOOLEAN"`
+}
+
+// Rows accumulates the rows of the tables for a set of blocks
+type Rows struct {
+	Blocks       []*Block
+	Transactions []*Transaction
+	Messages     []*Message
+	Events       []*Event
+	Attributes   []*Attribute
+}
+
+// Len returns the number of rows of a table
+func (r *Rows) Len(table Table) int {
+	switch table {
+	case BlocksTable:
+		return len(r.Blocks)
+	case TransactionsTable:
+		return len(r.Transactions)
+	case MessagesTable:
+		return len(r.Messages)
+	case EventsTable:
+		return len(r.Events)
+	case AttributesTable:
+		return len(r.Attributes)
+	}
+	return 0
+}
+
+// Reset drops the accumulated rows
+func (r *Rows) Reset() {
+	*r = Rows{}
+}
+
+// Append adds the rows of a block
+func (r *Rows) Append(block *pbcosmos.Block) error {
+	if block.Header == nil {
+		return fmt.Errorf("block has no header")
+	}
+	height := int64(block.Header.Height)
+	blockTime := timestampMillis(block.Header.Time)
+
+	events := transform.FlattenEvents(block).Events
+	r.Blocks = append(r.Blocks, &Block{
+		Height:          height,
+		Hash:            hexString(block.Header.Hash),
+		Time:            blockTime,
+		ChainID:         block.Header.ChainId,
+		ProposerAddress: hexString(block.Header.ProposerAddress),
+		TxCount:         int32(len(block.Transactions)),
+		EventCount:      int32(len(events)),
+	})
+
+	for _, tx := range block.Transactions {
+		row := &Transaction{
+			BlockHeight: height,
+			BlockTime:   blockTime,
+			Index:       int32(tx.Index),
+			Hash:        hexString(tx.Hash),
+		}
+		if tx.Result != nil {
+			row.Code = int64(tx.Result.Code)
+			row.Codespace = tx.Result.Codespace
+			row.GasWanted = tx.Result.GasWanted
+			row.GasUsed = tx.Result.GasUsed
+			row.EventCount = int32(len(tx.Result.Events))
+		}
+		if tx.Tx != nil && tx.Tx.AuthInfo != nil && tx.Tx.AuthInfo.Fee != nil {
+			fee := tx.Tx.AuthInfo.Fee
+			row.GasLimit = int64(fee.GasLimit)
+			row.Fee = formatCoins(fee.Amount)
+			row.Payer = fee.Payer
+			row.Granter = fee.Granter
+		}
+		if tx.Tx != nil && tx.Tx.Body != nil {
+			row.Memo = tx.Tx.Body.Memo
+			row.MessageCount = int32(len(tx.Tx.Body.Messages))
+
+			for i, message := range tx.Tx.Body.Messages {
+				json, err := MessageJSON(message)
+				if err != nil {
+					json = RawMessageJSON(message)
+				}
+				r.Messages = append(r.Messages, &Message{
+					BlockHeight: height,
+					BlockTime:   blockTime,
+					TxIndex:     row.Index,
+					TxHash:      row.Hash,
+					Index:       int32(i),
+					TypeURL:     message.TypeUrl,
+					JSON:        json,
+				})
+			}
+		}
+		r.Transactions = append(r.Transactions, row)
+	}
+
+	for _, flat := range events {
+		row := &Event{
+			ID:          flat.Id,
+			BlockHeight: height,
+			BlockTime:   blockTime,
+			Origin:      flat.Origin,
+			Index:       int32(flat.EventIndex),
+			Type:        flat.Event.EventType,
+		}
+		if flat.Origin == string(transform.DeliverTx) {
+			txIndex := int32(flat.TxIndex)
+			txHash := hexString(flat.TxHash)
+			row.TxIndex = &txIndex
+			row.TxHash = &txHash
+			if flat.MsgIndex >= 0 {
+				msgIndex := flat.MsgIndex
+				row.MsgIndex = &msgIndex
+			}
+		}
+		r.Events = append(r.Events, row)
+
+		for i, attr := range flat.Event.Attributes {
+			r.Attributes = append(r.Attributes, &Attribute{
+				EventID:     flat.Id,
+				BlockHeight: height,
+				EventType:   flat.Event.EventType,
+				Index:       int32(i),
+				Key:         string(attr.Key),
+				Value:       string(attr.Value),
+				Indexed:     attr.Index,
+			})
+		}
+	}
+
+	return nil
+}
+
+func timestampMillis(ts *pbcosmos.Timestamp) int64 {
+	if ts == nil {
+		return 0
+	}
+	return ts.Seconds*1000 + int64(ts.Nanos)/1e6
+}
+
+func hexString(value []byte) string {
+	return strings.ToUpper(hex.EncodeToString(value))
+}
+
+func formatCoins(coins []*pbcosmos.Coin) string {
+	parts := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		parts = append(parts, coin.Amount+coin.Denom)
+	}
+	return strings.Join(parts, ",")
+}
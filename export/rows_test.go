@@ -0,0 +1,180 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func event(eventType string, attributes ...string) *pbcosmos.Event {
+	out := &pbcosmos.Event{EventType: eventType}
+	for i := 0; i+1 < len(attributes); i += 2 {
+		out.Attributes = append(out.Attributes, &pbcosmos.EventAttribute{Key: []byte(attributes[i]), Value: []byte(attributes[i+1]), Index: true})
+	}
+	return out
+}
+
+func testBlock() *pbcosmos.Block {
+	send := msgSend("cosmos1from", "cosmos1to", coin("uatom", "1000"))
+	return &pbcosmos.Block{
+		Header: &pbcosmos.Header{
+			ChainId:         "cosmoshub-4",
+			Height:          12000004,
+			Hash:            []byte{0x0b, 0xcd},
+			Time:            &pbcosmos.Timestamp{Seconds: 1663000000, Nanos: 250000000},
+			ProposerAddress: []byte{0xab},
+		},
+		ResultBeginBlock: &pbcosmos.ResponseBeginBlock{Events: []*pbcosmos.Event{event("mint", "amount", "7000000uatom")}},
+		Transactions: []*pbcosmos.TxResult{{
+			Index: 0,
+			Hash:  []byte{0xaa},
+			Tx: &pbcosmos.Tx{
+				Body: &pbcosmos.TxBody{Messages: []*anypb.Any{send}, Memo: "thanks"},
+				AuthInfo: &pbcosmos.AuthInfo{Fee: &pbcosmos.Fee{
+					Amount:   []*pbcosmos.Coin{{Denom: "uatom", Amount: "500"}, {Denom: "uosmo", Amount: "1"}},
+					GasLimit: 200000,
+				}},
+			},
+			Result: &pbcosmos.ResponseDeliverTx{
+				GasWanted: 200000,
+				GasUsed:   81234,
+				Events: []*pbcosmos.Event{
+					event("tx", "fee", "500uatom"),
+					event("transfer", "recipient", "cosmos1to", "amount", "1000uatom", "msg_index", "0"),
+				},
+			},
+		}},
+		ResultEndBlock: &pbcosmos.ResponseEndBlock{Events: []*pbcosmos.Event{event("complete_unbonding")}},
+	}
+}
+
+func TestRows_Append(t *testing.T) {
+	rows := &Rows{}
+	require.NoError(t, rows.Append(testBlock()))
+
+	require.Len(t, rows.Blocks, 1)
+	assert.Equal(t, &Block{
+		Height:          12000004,
+		Hash:            "0BCD",
+		Time:            1663000000250,
+		ChainID:         "cosmoshub-4",
+		ProposerAddress: "AB",
+		TxCount:         1,
+		EventCount:      4,
+	}, rows.Blocks[0])
+
+	require.Len(t, rows.Transactions, 1)
+	assert.Equal(t, &Transaction{
+		BlockHeight:  12000004,
+		BlockTime:    1663000000250,
+		Index:        0,
+		Hash:         "AA",
+		GasWanted:    200000,
+		GasUsed:      81234,
+		GasLimit:     200000,
+		Fee:          "500uatom,1uosmo",
+		Memo:         "thanks",
+		MessageCount: 1,
+		EventCount:   2,
+	}, rows.Transactions[0])
+
+	require.Len(t, rows.Messages, 1)
+	assert.Equal(t, "/cosmos.bank.v1beta1.MsgSend", rows.Messages[0].TypeURL)
+	assert.Equal(t, "AA", rows.Messages[0].TxHash)
+	assert.JSONEq(t, `{"1":"cosmos1from","2":"cosmos1to","3":{"1":"uatom","2":"1000"}}`, rows.Messages[0].JSON)
+
+	require.Len(t, rows.Events, 4)
+	mint := rows.Events[0]
+	assert.Equal(t, "12000004/BeginBlock/0/0", mint.ID)
+	assert.Nil(t, mint.TxIndex)
+	assert.Nil(t, mint.TxHash)
+	assert.Nil(t, mint.MsgIndex)
+
+	// The ante handler event is not emitted by a message
+	fee := rows.Events[1]
+	require.NotNil(t, fee.TxHash)
+	assert.Equal(t, "AA", *fee.TxHash)
+	assert.Nil(t, fee.MsgIndex)
+
+	transfer := rows.Events[2]
+	assert.Equal(t, "12000004/DeliverTx/0/1", transfer.ID)
+	assert.Equal(t, "transfer", transfer.Type)
+	require.NotNil(t, transfer.MsgIndex)
+	assert.Equal(t, int32(0), *transfer.MsgIndex)
+
+	require.Len(t, rows.Attributes, 5)
+	assert.Equal(t, &Attribute{
+		EventID:     "12000004/DeliverTx/0/1",
+		BlockHeight: 12000004,
+		EventType:   "transfer",
+		Index:       1,
+		Key:         "amount",
+		Value:       "1000uatom",
+		Indexed:     true,
+	}, rows.Attributes[3])
+}
+
+func TestRows_Append_UndecodableMessage(t *testing.T) {
+	block := testBlock()
+	invalid := &anypb.Any{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{0x0a, 0x05, 'a'}}
+	block.Transactions[0].Tx.Body.Messages = append(block.Transactions[0].Tx.Body.Messages, invalid)
+
+	// The block is exported, the message holding its bytes
+	rows := &Rows{}
+	require.NoError(t, rows.Append(block))
+	require.Len(t, rows.Messages, 2)
+	assert.Equal(t, int32(1), rows.Messages[1].Index)
+	assert.JSONEq(t, `{"@bytes":"CgVh"}`, rows.Messages[1].JSON)
+}
+
+func TestWriteParquet(t *testing.T) {
+	rows := &Rows{}
+	require.NoError(t, rows.Append(testBlock()))
+
+	readRows := func(t *testing.T, data []byte, obj interface{}) (*reader.ParquetReader, int) {
+		file, err := buffer.NewBufferFile(data)
+		require.NoError(t, err)
+		pr, err := reader.NewParquetReader(file, obj, 1)
+		require.NoError(t, err)
+		return pr, int(pr.GetNumRows())
+	}
+
+	for _, table := range Tables {
+		t.Run(string(table), func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			require.NoError(t, WriteParquet(buf, table, rows))
+
+			_, count := readRows(t, buf.Bytes(), nil)
+			assert.Equal(t, rows.Len(table), count)
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		require.NoError(t, WriteParquet(buf, EventsTable, rows))
+
+		pr, count := readRows(t, buf.Bytes(), new(Event))
+		events := make([]Event, count)
+		require.NoError(t, pr.Read(&events))
+		pr.ReadStop()
+
+		require.Len(t, events, len(rows.Events))
+		for i, expected := range rows.Events {
+			assert.Equal(t, *expected, events[i])
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		require.NoError(t, WriteParquet(buf, MessagesTable, &Rows{}))
+
+		_, count := readRows(t, buf.Bytes(), new(Message))
+		assert.Equal(t, 0, count)
+	})
+}
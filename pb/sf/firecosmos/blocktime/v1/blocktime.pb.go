@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: sf/firecosmos/blocktime/v1/blocktime.proto
+
+package pbfcblocktime
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BlockAtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *BlockAtRequest) Reset() {
+	*x = BlockAtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_blocktime_v1_blocktime_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockAtRequest) ProtoMessage() {}
+
+func (x *BlockAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_blocktime_v1_blocktime_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockAtRequest.ProtoReflect.Descriptor instead.
+func (*BlockAtRequest) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlockAtRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type BlockAtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNum  uint64                 `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	BlockTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+}
+
+func (x *BlockAtResponse) Reset() {
+	*x = BlockAtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_blocktime_v1_blocktime_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockAtResponse) ProtoMessage() {}
+
+func (x *BlockAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_blocktime_v1_blocktime_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockAtResponse.ProtoReflect.Descriptor instead.
+func (*BlockAtResponse) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BlockAtResponse) GetBlockNum() uint64 {
+	if x != nil {
+		return x.BlockNum
+	}
+	return 0
+}
+
+func (x *BlockAtResponse) GetBlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockTime
+	}
+	return nil
+}
+
+var File_sf_firecosmos_blocktime_v1_blocktime_proto protoreflect.FileDescriptor
+
+var file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x73, 0x66,
+	0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x0e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x69, 0x0a, 0x0f, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x32, 0x6f, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x62, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x74, 0x12, 0x2a,
+	0x2e, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x66, 0x2e,
+	0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x56, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76,
+	0x31, 0x3b, 0x70, 0x62, 0x66, 0x63, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDescOnce sync.Once
+	file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDescData = file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDesc
+)
+
+func file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDescGZIP() []byte {
+	file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDescOnce.Do(func() {
+		file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDescData)
+	})
+	return file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDescData
+}
+
+var file_sf_firecosmos_blocktime_v1_blocktime_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sf_firecosmos_blocktime_v1_blocktime_proto_goTypes = []interface{}{
+	(*BlockAtRequest)(nil),        // 0: sf.firecosmos.blocktime.v1.BlockAtRequest
+	(*BlockAtResponse)(nil),       // 1: sf.firecosmos.blocktime.v1.BlockAtResponse
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_sf_firecosmos_blocktime_v1_blocktime_proto_depIdxs = []int32{
+	2, // 0: sf.firecosmos.blocktime.v1.BlockAtRequest.time:type_name -> google.protobuf.Timestamp
+	2, // 1: sf.firecosmos.blocktime.v1.BlockAtResponse.block_time:type_name -> google.protobuf.Timestamp
+	0, // 2: sf.firecosmos.blocktime.v1.BlockTime.BlockAt:input_type -> sf.firecosmos.blocktime.v1.BlockAtRequest
+	1, // 3: sf.firecosmos.blocktime.v1.BlockTime.BlockAt:output_type -> sf.firecosmos.blocktime.v1.BlockAtResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_sf_firecosmos_blocktime_v1_blocktime_proto_init() }
+func file_sf_firecosmos_blocktime_v1_blocktime_proto_init() {
+	if File_sf_firecosmos_blocktime_v1_blocktime_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sf_firecosmos_blocktime_v1_blocktime_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockAtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_blocktime_v1_blocktime_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockAtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sf_firecosmos_blocktime_v1_blocktime_proto_goTypes,
+		DependencyIndexes: file_sf_firecosmos_blocktime_v1_blocktime_proto_depIdxs,
+		MessageInfos:      file_sf_firecosmos_blocktime_v1_blocktime_proto_msgTypes,
+	}.Build()
+	File_sf_firecosmos_blocktime_v1_blocktime_proto = out.File
+	file_sf_firecosmos_blocktime_v1_blocktime_proto_rawDesc = nil
+	file_sf_firecosmos_blocktime_v1_blocktime_proto_goTypes = nil
+	file_sf_firecosmos_blocktime_v1_blocktime_proto_depIdxs = nil
+}
@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: sf/firecosmos/blocktime/v1/blocktime.proto
+
+package pbfcblocktime
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BlockTimeClient is the client API for BlockTime service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BlockTimeClient interface {
+	// BlockAt returns the first block produced at or after the requested time
+	BlockAt(ctx context.Context, in *BlockAtRequest, opts ...grpc.CallOption) (*BlockAtResponse, error)
+}
+
+type blockTimeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlockTimeClient(cc grpc.ClientConnInterface) BlockTimeClient {
+	return &blockTimeClient{cc}
+}
+
+func (c *blockTimeClient) BlockAt(ctx context.Context, in *BlockAtRequest, opts ...grpc.CallOption) (*BlockAtResponse, error) {
+	out := new(BlockAtResponse)
+	err := c.cc.Invoke(ctx, "/sf.firecosmos.blocktime.v1.BlockTime/BlockAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlockTimeServer is the server API for BlockTime service.
+// All implementations must embed UnimplementedBlockTimeServer
+// for forward compatibility
+type BlockTimeServer interface {
+	// BlockAt returns the first block produced at or after the requested time
+	BlockAt(context.Context, *BlockAtRequest) (*BlockAtResponse, error)
+	mustEmbedUnimplementedBlockTimeServer()
+}
+
+// UnimplementedBlockTimeServer must be embedded to have forward compatible implementations.
+type UnimplementedBlockTimeServer struct {
+}
+
+func (UnimplementedBlockTimeServer) BlockAt(context.Context, *BlockAtRequest) (*BlockAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockAt not implemented")
+}
+func (UnimplementedBlockTimeServer) mustEmbedUnimplementedBlockTimeServer() {}
+
+// UnsafeBlockTimeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlockTimeServer will
+// result in compilation errors.
+type UnsafeBlockTimeServer interface {
+	mustEmbedUnimplementedBlockTimeServer()
+}
+
+func RegisterBlockTimeServer(s grpc.ServiceRegistrar, srv BlockTimeServer) {
+	s.RegisterService(&BlockTime_ServiceDesc, srv)
+}
+
+func _BlockTime_BlockAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockTimeServer).BlockAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sf.firecosmos.blocktime.v1.BlockTime/BlockAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockTimeServer).BlockAt(ctx, req.(*BlockAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BlockTime_ServiceDesc is the grpc.ServiceDesc for BlockTime service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BlockTime_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sf.firecosmos.blocktime.v1.BlockTime",
+	HandlerType: (*BlockTimeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlockAt",
+			Handler:    _BlockTime_BlockAt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sf/firecosmos/blocktime/v1/blocktime.proto",
+}
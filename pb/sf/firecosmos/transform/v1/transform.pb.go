@@ -0,0 +1,752 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: sf/firecosmos/transform/v1/transform.proto
+
+package pbfctransform
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WasmContractFilter keeps the CosmWasm messages (execute, instantiate and migrate) and the `wasm` events of a set of contracts
+type WasmContractFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractAddresses []string `protobuf:"bytes,1,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
+	// Top-level keys of the JSON messages of execute messages to keep, every execute message is kept when empty
+	Methods []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (x *WasmContractFilter) Reset() {
+	*x = WasmContractFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WasmContractFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WasmContractFilter) ProtoMessage() {}
+
+func (x *WasmContractFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WasmContractFilter.ProtoReflect.Descriptor instead.
+func (*WasmContractFilter) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_transform_v1_transform_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WasmContractFilter) GetContractAddresses() []string {
+	if x != nil {
+		return x.ContractAddresses
+	}
+	return nil
+}
+
+func (x *WasmContractFilter) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+// IbcPacketFilter outputs a `sf.firecosmos.type.v1.IbcPacketBlock` with the IBC packets of the chain's channels and ports
+// listed, all the packets are kept when both are empty. For send, acknowledge and timeout stages, the chain's side of
+// the packet is its source, it is its destination for the recv and write acknowledgement stages.
+type IbcPacketFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channels []string `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	Ports    []string `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+}
+
+func (x *IbcPacketFilter) Reset() {
+	*x = IbcPacketFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IbcPacketFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IbcPacketFilter) ProtoMessage() {}
+
+func (x *IbcPacketFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IbcPacketFilter.ProtoReflect.Descriptor instead.
+func (*IbcPacketFilter) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_transform_v1_transform_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IbcPacketFilter) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *IbcPacketFilter) GetPorts() []string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+// ValidatorSetChangeFilter outputs the blocks reduced to their header and validator updates, both the validator set
+// updates reported by the node and the ones returned by the end blocker. When an index store is configured, only the
+// blocks changing the validator set are sent.
+type ValidatorSetChangeFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ValidatorSetChangeFilter) Reset() {
+	*x = ValidatorSetChangeFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorSetChangeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSetChangeFilter) ProtoMessage() {}
+
+func (x *ValidatorSetChangeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorSetChangeFilter.ProtoReflect.Descriptor instead.
+func (*ValidatorSetChangeFilter) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_transform_v1_transform_proto_rawDescGZIP(), []int{2}
+}
+
+// HeaderOnly outputs a `sf.firecosmos.type.v1.LightBlock` for every block, holding its header, a summary of the
+// signatures of its last commit and the counts of its transactions and events
+type HeaderOnly struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HeaderOnly) Reset() {
+	*x = HeaderOnly{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaderOnly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderOnly) ProtoMessage() {}
+
+func (x *HeaderOnly) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderOnly.ProtoReflect.Descriptor instead.
+func (*HeaderOnly) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_transform_v1_transform_proto_rawDescGZIP(), []int{3}
+}
+
+// FeeAccounting outputs a `sf.firecosmos.type.v1.FeeBlock` for every block, replacing its transactions with their fee,
+// gas and payer
+type FeeAccounting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FeeAccounting) Reset() {
+	*x = FeeAccounting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeAccounting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeAccounting) ProtoMessage() {}
+
+func (x *FeeAccounting) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeAccounting.ProtoReflect.Descriptor instead.
+func (*FeeAccounting) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_transform_v1_transform_proto_rawDescGZIP(), []int{4}
+}
+
+// GovernanceFilter outputs a `sf.firecosmos.type.v1.GovernanceBlock` with the governance records of the proposals
+// listed, all the records are kept when empty
+type GovernanceFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalIds []uint64 `protobuf:"varint,1,rep,packed,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"`
+}
+
+func (x *GovernanceFilter) Reset() {
+	*x = GovernanceFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GovernanceFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovernanceFilter) ProtoMessage() {}
+
+func (x *GovernanceFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GovernanceFilter.ProtoReflect.Descriptor instead.
+func (*GovernanceFilter) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_transform_v1_transform_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GovernanceFilter) GetProposalIds() []uint64 {
+	if x != nil {
+		return x.ProposalIds
+	}
+	return nil
+}
+
+// BalanceChangeFilter outputs a `sf.firecosmos.type.v1.BalanceChangeBlock` with the balance changes of the addresses
+// and the denoms listed, both lists are optional and are combined when both set. Denoms support the `*` wildcard
+// (ex: `ibc/*`).
+type BalanceChangeFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Denoms    []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (x *BalanceChangeFilter) Reset() {
+	*x = BalanceChangeFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChangeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChangeFilter) ProtoMessage() {}
+
+func (x *BalanceChangeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChangeFilter.ProtoReflect.Descriptor instead.
+func (*BalanceChangeFilter) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_transform_v1_transform_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BalanceChangeFilter) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *BalanceChangeFilter) GetDenoms() []string {
+	if x != nil {
+		return x.Denoms
+	}
+	return nil
+}
+
+// StakingFilter outputs a `sf.firecosmos.type.v1.StakingBlock` with the staking and distribution records of the
+// delegators and the validator operators listed, a record is kept when either matches. All the records are kept when
+// both are empty, the rewards and commission allocated by the begin blocker being only kept then.
+type StakingFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegators []string `protobuf:"bytes,1,rep,name=delegators,proto3" json:"delegators,omitempty"`
+	Validators []string `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (x *StakingFilter) Reset() {
+	*x = StakingFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingFilter) ProtoMessage() {}
+
+func (x *StakingFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingFilter.ProtoReflect.Descriptor instead.
+func (*StakingFilter) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_transform_v1_transform_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StakingFilter) GetDelegators() []string {
+	if x != nil {
+		return x.Delegators
+	}
+	return nil
+}
+
+func (x *StakingFilter) GetValidators() []string {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+// EventsOnly outputs a `sf.firecosmos.type.v1.EventsBlock` for every block, flattening its events in a single list
+// with their origin, transaction and identifier. It must be the first transform of a request, the event type and event
+// origin filters placed after it filter the flattened events, keeping the identifiers of the unfiltered block.
+type EventsOnly struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EventsOnly) Reset() {
+	*x = EventsOnly{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsOnly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsOnly) ProtoMessage() {}
+
+func (x *EventsOnly) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsOnly.ProtoReflect.Descriptor instead.
+func (*EventsOnly) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_transform_v1_transform_proto_rawDescGZIP(), []int{8}
+}
+
+// NormalizeAttributes decodes the base64 encoded event attributes of blocks written without normalization by chains
+// running Tendermint up to v0.34. Placed first, the filters following it match the decoded attributes.
+type NormalizeAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of `base64`, decoding the attributes of every block, or `auto`, detecting the encoded attributes on each
+	// block, `auto` when empty
+	Encoding string `protobuf:"bytes,1,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// Last block height which event attributes can be base64 encoded, the blocks above are kept as is, no limit when 0
+	UntilHeight uint64 `protobuf:"varint,2,opt,name=until_height,json=untilHeight,proto3" json:"until_height,omitempty"`
+}
+
+func (x *NormalizeAttributes) Reset() {
+	*x = NormalizeAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NormalizeAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeAttributes) ProtoMessage() {}
+
+func (x *NormalizeAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_transform_v1_transform_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeAttributes.ProtoReflect.Descriptor instead.
+func (*NormalizeAttributes) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_transform_v1_transform_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NormalizeAttributes) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *NormalizeAttributes) GetUntilHeight() uint64 {
+	if x != nil {
+		return x.UntilHeight
+	}
+	return 0
+}
+
+var File_sf_firecosmos_transform_v1_transform_proto protoreflect.FileDescriptor
+
+var file_sf_firecosmos_transform_v1_transform_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x73, 0x66,
+	0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x22, 0x5d, 0x0a, 0x12, 0x57, 0x61, 0x73, 0x6d,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2d,
+	0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x0f, 0x49, 0x62, 0x63, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x1a, 0x0a, 0x18,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x0c, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x0f, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x35, 0x0a, 0x10, 0x47, 0x6f, 0x76, 0x65, 0x72,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x22, 0x4b,
+	0x0a, 0x13, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x0c, 0x0a, 0x0a,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x54, 0x0a, 0x13, 0x4e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a,
+	0x0c, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x42, 0x56, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x66, 0x69, 0x72,
+	0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x70, 0x62, 0x2f,
+	0x73, 0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x66, 0x63, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sf_firecosmos_transform_v1_transform_proto_rawDescOnce sync.Once
+	file_sf_firecosmos_transform_v1_transform_proto_rawDescData = file_sf_firecosmos_transform_v1_transform_proto_rawDesc
+)
+
+func file_sf_firecosmos_transform_v1_transform_proto_rawDescGZIP() []byte {
+	file_sf_firecosmos_transform_v1_transform_proto_rawDescOnce.Do(func() {
+		file_sf_firecosmos_transform_v1_transform_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_firecosmos_transform_v1_transform_proto_rawDescData)
+	})
+	return file_sf_firecosmos_transform_v1_transform_proto_rawDescData
+}
+
+var file_sf_firecosmos_transform_v1_transform_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_sf_firecosmos_transform_v1_transform_proto_goTypes = []interface{}{
+	(*WasmContractFilter)(nil),       // 0: sf.firecosmos.transform.v1.WasmContractFilter
+	(*IbcPacketFilter)(nil),          // 1: sf.firecosmos.transform.v1.IbcPacketFilter
+	(*ValidatorSetChangeFilter)(nil), // 2: sf.firecosmos.transform.v1.ValidatorSetChangeFilter
+	(*HeaderOnly)(nil),               // 3: sf.firecosmos.transform.v1.HeaderOnly
+	(*FeeAccounting)(nil),            // 4: sf.firecosmos.transform.v1.FeeAccounting
+	(*GovernanceFilter)(nil),         // 5: sf.firecosmos.transform.v1.GovernanceFilter
+	(*BalanceChangeFilter)(nil),      // 6: sf.firecosmos.transform.v1.BalanceChangeFilter
+	(*StakingFilter)(nil),            // 7: sf.firecosmos.transform.v1.StakingFilter
+	(*EventsOnly)(nil),               // 8: sf.firecosmos.transform.v1.EventsOnly
+	(*NormalizeAttributes)(nil),      // 9: sf.firecosmos.transform.v1.NormalizeAttributes
+}
+var file_sf_firecosmos_transform_v1_transform_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_sf_firecosmos_transform_v1_transform_proto_init() }
+func file_sf_firecosmos_transform_v1_transform_proto_init() {
+	if File_sf_firecosmos_transform_v1_transform_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sf_firecosmos_transform_v1_transform_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WasmContractFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_transform_v1_transform_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IbcPacketFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_transform_v1_transform_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorSetChangeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_transform_v1_transform_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaderOnly); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_transform_v1_transform_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeAccounting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_transform_v1_transform_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GovernanceFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_transform_v1_transform_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChangeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_transform_v1_transform_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_transform_v1_transform_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventsOnly); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_transform_v1_transform_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NormalizeAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sf_firecosmos_transform_v1_transform_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_firecosmos_transform_v1_transform_proto_goTypes,
+		DependencyIndexes: file_sf_firecosmos_transform_v1_transform_proto_depIdxs,
+		MessageInfos:      file_sf_firecosmos_transform_v1_transform_proto_msgTypes,
+	}.Build()
+	File_sf_firecosmos_transform_v1_transform_proto = out.File
+	file_sf_firecosmos_transform_v1_transform_proto_rawDesc = nil
+	file_sf_firecosmos_transform_v1_transform_proto_goTypes = nil
+	file_sf_firecosmos_transform_v1_transform_proto_depIdxs = nil
+}
@@ -0,0 +1,369 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: sf/firecosmos/type/v1/balance.proto
+
+package pbfctype
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EventOrigin int32
+
+const (
+	EventOrigin_EVENT_ORIGIN_UNSPECIFIED EventOrigin = 0
+	EventOrigin_EVENT_ORIGIN_BEGIN_BLOCK EventOrigin = 1
+	EventOrigin_EVENT_ORIGIN_DELIVER_TX  EventOrigin = 2
+	EventOrigin_EVENT_ORIGIN_END_BLOCK   EventOrigin = 3
+)
+
+// Enum value maps for EventOrigin.
+var (
+	EventOrigin_name = map[int32]string{
+		0: "EVENT_ORIGIN_UNSPECIFIED",
+		1: "EVENT_ORIGIN_BEGIN_BLOCK",
+		2: "EVENT_ORIGIN_DELIVER_TX",
+		3: "EVENT_ORIGIN_END_BLOCK",
+	}
+	EventOrigin_value = map[string]int32{
+		"EVENT_ORIGIN_UNSPECIFIED": 0,
+		"EVENT_ORIGIN_BEGIN_BLOCK": 1,
+		"EVENT_ORIGIN_DELIVER_TX":  2,
+		"EVENT_ORIGIN_END_BLOCK":   3,
+	}
+)
+
+func (x EventOrigin) Enum() *EventOrigin {
+	p := new(EventOrigin)
+	*p = x
+	return p
+}
+
+func (x EventOrigin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventOrigin) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_firecosmos_type_v1_balance_proto_enumTypes[0].Descriptor()
+}
+
+func (EventOrigin) Type() protoreflect.EnumType {
+	return &file_sf_firecosmos_type_v1_balance_proto_enumTypes[0]
+}
+
+func (x EventOrigin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventOrigin.Descriptor instead.
+func (EventOrigin) EnumDescriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_balance_proto_rawDescGZIP(), []int{0}
+}
+
+// BalanceChangeBlock holds the balance changes of a block, derived from the events of the bank module
+type BalanceChangeBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height  uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash    []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Changes []*BalanceChange       `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *BalanceChangeBlock) Reset() {
+	*x = BalanceChangeBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_balance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChangeBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChangeBlock) ProtoMessage() {}
+
+func (x *BalanceChangeBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_balance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChangeBlock.ProtoReflect.Descriptor instead.
+func (*BalanceChangeBlock) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_balance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BalanceChangeBlock) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BalanceChangeBlock) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *BalanceChangeBlock) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *BalanceChangeBlock) GetChanges() []*BalanceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// BalanceChange is the net change of the balance of an address in a denom, within the begin blocker, a transaction or
+// the end blocker
+type BalanceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Signed integer amount, ex: `-2500`
+	Amount string      `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Origin EventOrigin `protobuf:"varint,4,opt,name=origin,proto3,enum=sf.firecosmos.type.v1.EventOrigin" json:"origin,omitempty"`
+	// Set for the changes of the deliver tx origin
+	TxHash  []byte `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxIndex uint32 `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+}
+
+func (x *BalanceChange) Reset() {
+	*x = BalanceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_balance_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChange) ProtoMessage() {}
+
+func (x *BalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_balance_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChange.ProtoReflect.Descriptor instead.
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_balance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BalanceChange) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetOrigin() EventOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return EventOrigin_EVENT_ORIGIN_UNSPECIFIED
+}
+
+func (x *BalanceChange) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetTxIndex() uint32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+var File_sf_firecosmos_type_v1_balance_proto protoreflect.FileDescriptor
+
+var file_sf_firecosmos_type_v1_balance_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x01,
+	0x0a, 0x12, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x3e, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x22, 0xc7, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x66, 0x2e,
+	0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2a, 0x82, 0x01, 0x0a, 0x0b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x54,
+	0x58, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x49,
+	0x47, 0x49, 0x4e, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x42,
+	0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x66, 0x69, 0x72, 0x65,
+	0x68, 0x6f, 0x73, 0x65, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x73,
+	0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x66, 0x63, 0x74, 0x79, 0x70, 0x65, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sf_firecosmos_type_v1_balance_proto_rawDescOnce sync.Once
+	file_sf_firecosmos_type_v1_balance_proto_rawDescData = file_sf_firecosmos_type_v1_balance_proto_rawDesc
+)
+
+func file_sf_firecosmos_type_v1_balance_proto_rawDescGZIP() []byte {
+	file_sf_firecosmos_type_v1_balance_proto_rawDescOnce.Do(func() {
+		file_sf_firecosmos_type_v1_balance_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_firecosmos_type_v1_balance_proto_rawDescData)
+	})
+	return file_sf_firecosmos_type_v1_balance_proto_rawDescData
+}
+
+var file_sf_firecosmos_type_v1_balance_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sf_firecosmos_type_v1_balance_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sf_firecosmos_type_v1_balance_proto_goTypes = []interface{}{
+	(EventOrigin)(0),              // 0: sf.firecosmos.type.v1.EventOrigin
+	(*BalanceChangeBlock)(nil),    // 1: sf.firecosmos.type.v1.BalanceChangeBlock
+	(*BalanceChange)(nil),         // 2: sf.firecosmos.type.v1.BalanceChange
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_sf_firecosmos_type_v1_balance_proto_depIdxs = []int32{
+	3, // 0: sf.firecosmos.type.v1.BalanceChangeBlock.time:type_name -> google.protobuf.Timestamp
+	2, // 1: sf.firecosmos.type.v1.BalanceChangeBlock.changes:type_name -> sf.firecosmos.type.v1.BalanceChange
+	0, // 2: sf.firecosmos.type.v1.BalanceChange.origin:type_name -> sf.firecosmos.type.v1.EventOrigin
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_sf_firecosmos_type_v1_balance_proto_init() }
+func file_sf_firecosmos_type_v1_balance_proto_init() {
+	if File_sf_firecosmos_type_v1_balance_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sf_firecosmos_type_v1_balance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChangeBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_type_v1_balance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sf_firecosmos_type_v1_balance_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_firecosmos_type_v1_balance_proto_goTypes,
+		DependencyIndexes: file_sf_firecosmos_type_v1_balance_proto_depIdxs,
+		EnumInfos:         file_sf_firecosmos_type_v1_balance_proto_enumTypes,
+		MessageInfos:      file_sf_firecosmos_type_v1_balance_proto_msgTypes,
+	}.Build()
+	File_sf_firecosmos_type_v1_balance_proto = out.File
+	file_sf_firecosmos_type_v1_balance_proto_rawDesc = nil
+	file_sf_firecosmos_type_v1_balance_proto_goTypes = nil
+	file_sf_firecosmos_type_v1_balance_proto_depIdxs = nil
+}
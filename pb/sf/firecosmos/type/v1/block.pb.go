@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: sf/firecosmos/type/v1/block.proto
+
+package pbfctype
+
+import (
+	v1 "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LightBlock holds the header of a block along with a summary of its content, without any transaction, event or evidence
+type LightBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header        *v1.Header     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	LastCommit    *CommitSummary `protobuf:"bytes,2,opt,name=last_commit,json=lastCommit,proto3" json:"last_commit,omitempty"`
+	TxCount       uint32         `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	FailedTxCount uint32         `protobuf:"varint,4,opt,name=failed_tx_count,json=failedTxCount,proto3" json:"failed_tx_count,omitempty"`
+	// Events emitted by the begin blocker, the transactions and the end blocker
+	EventCount           uint32 `protobuf:"varint,5,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	EvidenceCount        uint32 `protobuf:"varint,6,opt,name=evidence_count,json=evidenceCount,proto3" json:"evidence_count,omitempty"`
+	ValidatorUpdateCount uint32 `protobuf:"varint,7,opt,name=validator_update_count,json=validatorUpdateCount,proto3" json:"validator_update_count,omitempty"`
+	GasWanted            int64  `protobuf:"varint,8,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	GasUsed              int64  `protobuf:"varint,9,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (x *LightBlock) Reset() {
+	*x = LightBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_block_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LightBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LightBlock) ProtoMessage() {}
+
+func (x *LightBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_block_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LightBlock.ProtoReflect.Descriptor instead.
+func (*LightBlock) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_block_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LightBlock) GetHeader() *v1.Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *LightBlock) GetLastCommit() *CommitSummary {
+	if x != nil {
+		return x.LastCommit
+	}
+	return nil
+}
+
+func (x *LightBlock) GetTxCount() uint32 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
+func (x *LightBlock) GetFailedTxCount() uint32 {
+	if x != nil {
+		return x.FailedTxCount
+	}
+	return 0
+}
+
+func (x *LightBlock) GetEventCount() uint32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *LightBlock) GetEvidenceCount() uint32 {
+	if x != nil {
+		return x.EvidenceCount
+	}
+	return 0
+}
+
+func (x *LightBlock) GetValidatorUpdateCount() uint32 {
+	if x != nil {
+		return x.ValidatorUpdateCount
+	}
+	return 0
+}
+
+func (x *LightBlock) GetGasWanted() int64 {
+	if x != nil {
+		return x.GasWanted
+	}
+	return 0
+}
+
+func (x *LightBlock) GetGasUsed() int64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+// CommitSummary counts the signatures of the commit of the previous block by block ID flag
+type CommitSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height         int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round          int32  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	BlockHash      []byte `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	SignatureCount uint32 `protobuf:"varint,4,opt,name=signature_count,json=signatureCount,proto3" json:"signature_count,omitempty"`
+	CommitCount    uint32 `protobuf:"varint,5,opt,name=commit_count,json=commitCount,proto3" json:"commit_count,omitempty"`
+	NilCount       uint32 `protobuf:"varint,6,opt,name=nil_count,json=nilCount,proto3" json:"nil_count,omitempty"`
+	AbsentCount    uint32 `protobuf:"varint,7,opt,name=absent_count,json=absentCount,proto3" json:"absent_count,omitempty"`
+}
+
+func (x *CommitSummary) Reset() {
+	*x = CommitSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_block_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitSummary) ProtoMessage() {}
+
+func (x *CommitSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_block_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitSummary.ProtoReflect.Descriptor instead.
+func (*CommitSummary) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_block_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CommitSummary) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CommitSummary) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *CommitSummary) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *CommitSummary) GetSignatureCount() uint32 {
+	if x != nil {
+		return x.SignatureCount
+	}
+	return 0
+}
+
+func (x *CommitSummary) GetCommitCount() uint32 {
+	if x != nil {
+		return x.CommitCount
+	}
+	return 0
+}
+
+func (x *CommitSummary) GetNilCount() uint32 {
+	if x != nil {
+		return x.NilCount
+	}
+	return 0
+}
+
+func (x *CommitSummary) GetAbsentCount() uint32 {
+	if x != nil {
+		return x.AbsentCount
+	}
+	return 0
+}
+
+// BlockTransaction holds a transaction along with the header of the block including it
+type BlockTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *v1.Header   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Tx     *v1.TxResult `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (x *BlockTransaction) Reset() {
+	*x = BlockTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_block_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTransaction) ProtoMessage() {}
+
+func (x *BlockTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_block_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTransaction.ProtoReflect.Descriptor instead.
+func (*BlockTransaction) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_block_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BlockTransaction) GetHeader() *v1.Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *BlockTransaction) GetTx() *v1.TxResult {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+var File_sf_firecosmos_type_v1_block_proto protoreflect.FileDescriptor
+
+var file_sf_firecosmos_type_v1_block_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x15, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x73, 0x66, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x03, 0x0a, 0x0a, 0x4c, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x31, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x66, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x78, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x65,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x77, 0x61, 0x6e, 0x74, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x61, 0x73, 0x57, 0x61, 0x6e, 0x74, 0x65,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x22, 0xe8, 0x01, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x69, 0x6c, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x69, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x72, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x66,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2b,
+	0x0a, 0x02, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x02, 0x74, 0x78, 0x42, 0x4c, 0x5a, 0x4a, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65,
+	0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x66, 0x2f, 0x66, 0x69,
+	0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31,
+	0x3b, 0x70, 0x62, 0x66, 0x63, 0x74, 0x79, 0x70, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_sf_firecosmos_type_v1_block_proto_rawDescOnce sync.Once
+	file_sf_firecosmos_type_v1_block_proto_rawDescData = file_sf_firecosmos_type_v1_block_proto_rawDesc
+)
+
+func file_sf_firecosmos_type_v1_block_proto_rawDescGZIP() []byte {
+	file_sf_firecosmos_type_v1_block_proto_rawDescOnce.Do(func() {
+		file_sf_firecosmos_type_v1_block_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_firecosmos_type_v1_block_proto_rawDescData)
+	})
+	return file_sf_firecosmos_type_v1_block_proto_rawDescData
+}
+
+var file_sf_firecosmos_type_v1_block_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_sf_firecosmos_type_v1_block_proto_goTypes = []interface{}{
+	(*LightBlock)(nil),       // 0: sf.firecosmos.type.v1.LightBlock
+	(*CommitSummary)(nil),    // 1: sf.firecosmos.type.v1.CommitSummary
+	(*BlockTransaction)(nil), // 2: sf.firecosmos.type.v1.BlockTransaction
+	(*v1.Header)(nil),        // 3: sf.cosmos.type.v1.Header
+	(*v1.TxResult)(nil),      // 4: sf.cosmos.type.v1.TxResult
+}
+var file_sf_firecosmos_type_v1_block_proto_depIdxs = []int32{
+	3, // 0: sf.firecosmos.type.v1.LightBlock.header:type_name -> sf.cosmos.type.v1.Header
+	1, // 1: sf.firecosmos.type.v1.LightBlock.last_commit:type_name -> sf.firecosmos.type.v1.CommitSummary
+	3, // 2: sf.firecosmos.type.v1.BlockTransaction.header:type_name -> sf.cosmos.type.v1.Header
+	4, // 3: sf.firecosmos.type.v1.BlockTransaction.tx:type_name -> sf.cosmos.type.v1.TxResult
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_sf_firecosmos_type_v1_block_proto_init() }
+func file_sf_firecosmos_type_v1_block_proto_init() {
+	if File_sf_firecosmos_type_v1_block_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sf_firecosmos_type_v1_block_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LightBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_type_v1_block_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_type_v1_block_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sf_firecosmos_type_v1_block_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_firecosmos_type_v1_block_proto_goTypes,
+		DependencyIndexes: file_sf_firecosmos_type_v1_block_proto_depIdxs,
+		MessageInfos:      file_sf_firecosmos_type_v1_block_proto_msgTypes,
+	}.Build()
+	File_sf_firecosmos_type_v1_block_proto = out.File
+	file_sf_firecosmos_type_v1_block_proto_rawDesc = nil
+	file_sf_firecosmos_type_v1_block_proto_goTypes = nil
+	file_sf_firecosmos_type_v1_block_proto_depIdxs = nil
+}
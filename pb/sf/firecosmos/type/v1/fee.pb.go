@@ -0,0 +1,379 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: sf/firecosmos/type/v1/fee.proto
+
+package pbfctype
+
+import (
+	v1 "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FeeBlock holds the fee and gas of each transaction of a block, along with their totals
+type FeeBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash   []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Time   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Txs    []*TxFee               `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+	// Sum of the fees of the transactions by denom, sorted by denom
+	TotalFees      []*v1.Coin `protobuf:"bytes,5,rep,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	TotalGasLimit  uint64     `protobuf:"varint,6,opt,name=total_gas_limit,json=totalGasLimit,proto3" json:"total_gas_limit,omitempty"`
+	TotalGasWanted int64      `protobuf:"varint,7,opt,name=total_gas_wanted,json=totalGasWanted,proto3" json:"total_gas_wanted,omitempty"`
+	TotalGasUsed   int64      `protobuf:"varint,8,opt,name=total_gas_used,json=totalGasUsed,proto3" json:"total_gas_used,omitempty"`
+}
+
+func (x *FeeBlock) Reset() {
+	*x = FeeBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_fee_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeBlock) ProtoMessage() {}
+
+func (x *FeeBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_fee_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeBlock.ProtoReflect.Descriptor instead.
+func (*FeeBlock) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_fee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FeeBlock) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *FeeBlock) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *FeeBlock) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *FeeBlock) GetTxs() []*TxFee {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *FeeBlock) GetTotalFees() []*v1.Coin {
+	if x != nil {
+		return x.TotalFees
+	}
+	return nil
+}
+
+func (x *FeeBlock) GetTotalGasLimit() uint64 {
+	if x != nil {
+		return x.TotalGasLimit
+	}
+	return 0
+}
+
+func (x *FeeBlock) GetTotalGasWanted() int64 {
+	if x != nil {
+		return x.TotalGasWanted
+	}
+	return 0
+}
+
+func (x *FeeBlock) GetTotalGasUsed() int64 {
+	if x != nil {
+		return x.TotalGasUsed
+	}
+	return 0
+}
+
+type TxFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash  []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Result code of the transaction, fees are charged to failed transactions too
+	Code      uint32     `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Fee       []*v1.Coin `protobuf:"bytes,4,rep,name=fee,proto3" json:"fee,omitempty"`
+	GasLimit  uint64     `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasWanted int64      `protobuf:"varint,6,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	GasUsed   int64      `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// Account paying the fee, the fee payer of the transaction when set, its first signer otherwise. It is taken from the
+	// events of the ante handler when the transaction does not set it.
+	Payer string `protobuf:"bytes,8,opt,name=payer,proto3" json:"payer,omitempty"`
+	// Account granting a fee allowance to the payer, if any
+	Granter string `protobuf:"bytes,9,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (x *TxFee) Reset() {
+	*x = TxFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_fee_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxFee) ProtoMessage() {}
+
+func (x *TxFee) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_fee_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxFee.ProtoReflect.Descriptor instead.
+func (*TxFee) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_fee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TxFee) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *TxFee) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TxFee) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *TxFee) GetFee() []*v1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *TxFee) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *TxFee) GetGasWanted() int64 {
+	if x != nil {
+		return x.GasWanted
+	}
+	return 0
+}
+
+func (x *TxFee) GetGasUsed() int64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *TxFee) GetPayer() string {
+	if x != nil {
+		return x.Payer
+	}
+	return ""
+}
+
+func (x *TxFee) GetGranter() string {
+	if x != nil {
+		return x.Granter
+	}
+	return ""
+}
+
+var File_sf_firecosmos_type_v1_fee_proto protoreflect.FileDescriptor
+
+var file_sf_firecosmos_type_v1_fee_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x15, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x73, 0x66, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x02, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x2e, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x46, 0x65, 0x65, 0x52, 0x03, 0x74, 0x78, 0x73,
+	0x12, 0x36, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x77, 0x61,
+	0x6e, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x47, 0x61, 0x73, 0x57, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64,
+	0x22, 0xf7, 0x01, 0x0a, 0x05, 0x54, 0x78, 0x46, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x77, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x61, 0x73, 0x57, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72,
+	0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x3b,
+	0x70, 0x62, 0x66, 0x63, 0x74, 0x79, 0x70, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sf_firecosmos_type_v1_fee_proto_rawDescOnce sync.Once
+	file_sf_firecosmos_type_v1_fee_proto_rawDescData = file_sf_firecosmos_type_v1_fee_proto_rawDesc
+)
+
+func file_sf_firecosmos_type_v1_fee_proto_rawDescGZIP() []byte {
+	file_sf_firecosmos_type_v1_fee_proto_rawDescOnce.Do(func() {
+		file_sf_firecosmos_type_v1_fee_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_firecosmos_type_v1_fee_proto_rawDescData)
+	})
+	return file_sf_firecosmos_type_v1_fee_proto_rawDescData
+}
+
+var file_sf_firecosmos_type_v1_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sf_firecosmos_type_v1_fee_proto_goTypes = []interface{}{
+	(*FeeBlock)(nil),              // 0: sf.firecosmos.type.v1.FeeBlock
+	(*TxFee)(nil),                 // 1: sf.firecosmos.type.v1.TxFee
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*v1.Coin)(nil),               // 3: sf.cosmos.type.v1.Coin
+}
+var file_sf_firecosmos_type_v1_fee_proto_depIdxs = []int32{
+	2, // 0: sf.firecosmos.type.v1.FeeBlock.time:type_name -> google.protobuf.Timestamp
+	1, // 1: sf.firecosmos.type.v1.FeeBlock.txs:type_name -> sf.firecosmos.type.v1.TxFee
+	3, // 2: sf.firecosmos.type.v1.FeeBlock.total_fees:type_name -> sf.cosmos.type.v1.Coin
+	3, // 3: sf.firecosmos.type.v1.TxFee.fee:type_name -> sf.cosmos.type.v1.Coin
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_sf_firecosmos_type_v1_fee_proto_init() }
+func file_sf_firecosmos_type_v1_fee_proto_init() {
+	if File_sf_firecosmos_type_v1_fee_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sf_firecosmos_type_v1_fee_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_type_v1_fee_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sf_firecosmos_type_v1_fee_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_firecosmos_type_v1_fee_proto_goTypes,
+		DependencyIndexes: file_sf_firecosmos_type_v1_fee_proto_depIdxs,
+		MessageInfos:      file_sf_firecosmos_type_v1_fee_proto_msgTypes,
+	}.Build()
+	File_sf_firecosmos_type_v1_fee_proto = out.File
+	file_sf_firecosmos_type_v1_fee_proto_rawDesc = nil
+	file_sf_firecosmos_type_v1_fee_proto_goTypes = nil
+	file_sf_firecosmos_type_v1_fee_proto_depIdxs = nil
+}
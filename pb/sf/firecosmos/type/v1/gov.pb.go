@@ -0,0 +1,444 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: sf/firecosmos/type/v1/gov.proto
+
+package pbfctype
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GovernanceRecordType int32
+
+const (
+	GovernanceRecordType_GOVERNANCE_RECORD_TYPE_UNSPECIFIED GovernanceRecordType = 0
+	// A proposal was submitted (`submit_proposal` event)
+	GovernanceRecordType_GOVERNANCE_RECORD_TYPE_SUBMIT_PROPOSAL GovernanceRecordType = 1
+	// A deposit was made on a proposal, including the initial deposit (`proposal_deposit` event)
+	GovernanceRecordType_GOVERNANCE_RECORD_TYPE_DEPOSIT GovernanceRecordType = 2
+	// A vote was cast (`proposal_vote` event)
+	GovernanceRecordType_GOVERNANCE_RECORD_TYPE_VOTE GovernanceRecordType = 3
+	// The voting period of a proposal ended, see result (`active_proposal` end block event)
+	GovernanceRecordType_GOVERNANCE_RECORD_TYPE_VOTING_PERIOD_END GovernanceRecordType = 4
+	// The deposit period of a proposal ended without reaching the minimum deposit (`inactive_proposal` end block event)
+	GovernanceRecordType_GOVERNANCE_RECORD_TYPE_DEPOSIT_PERIOD_END GovernanceRecordType = 5
+)
+
+// Enum value maps for GovernanceRecordType.
+var (
+	GovernanceRecordType_name = map[int32]string{
+		0: "GOVERNANCE_RECORD_TYPE_UNSPECIFIED",
+		1: "GOVERNANCE_RECORD_TYPE_SUBMIT_PROPOSAL",
+		2: "GOVERNANCE_RECORD_TYPE_DEPOSIT",
+		3: "GOVERNANCE_RECORD_TYPE_VOTE",
+		4: "GOVERNANCE_RECORD_TYPE_VOTING_PERIOD_END",
+		5: "GOVERNANCE_RECORD_TYPE_DEPOSIT_PERIOD_END",
+	}
+	GovernanceRecordType_value = map[string]int32{
+		"GOVERNANCE_RECORD_TYPE_UNSPECIFIED":        0,
+		"GOVERNANCE_RECORD_TYPE_SUBMIT_PROPOSAL":    1,
+		"GOVERNANCE_RECORD_TYPE_DEPOSIT":            2,
+		"GOVERNANCE_RECORD_TYPE_VOTE":               3,
+		"GOVERNANCE_RECORD_TYPE_VOTING_PERIOD_END":  4,
+		"GOVERNANCE_RECORD_TYPE_DEPOSIT_PERIOD_END": 5,
+	}
+)
+
+func (x GovernanceRecordType) Enum() *GovernanceRecordType {
+	p := new(GovernanceRecordType)
+	*p = x
+	return p
+}
+
+func (x GovernanceRecordType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GovernanceRecordType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_firecosmos_type_v1_gov_proto_enumTypes[0].Descriptor()
+}
+
+func (GovernanceRecordType) Type() protoreflect.EnumType {
+	return &file_sf_firecosmos_type_v1_gov_proto_enumTypes[0]
+}
+
+func (x GovernanceRecordType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GovernanceRecordType.Descriptor instead.
+func (GovernanceRecordType) EnumDescriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_gov_proto_rawDescGZIP(), []int{0}
+}
+
+// GovernanceBlock holds the governance events of a block, from its transactions and its end blocker, normalized as
+// one record per event
+type GovernanceBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height  uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash    []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Records []*GovernanceRecord    `protobuf:"bytes,4,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *GovernanceBlock) Reset() {
+	*x = GovernanceBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_gov_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GovernanceBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovernanceBlock) ProtoMessage() {}
+
+func (x *GovernanceBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_gov_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GovernanceBlock.ProtoReflect.Descriptor instead.
+func (*GovernanceBlock) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_gov_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GovernanceBlock) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GovernanceBlock) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *GovernanceBlock) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *GovernanceBlock) GetRecords() []*GovernanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type GovernanceRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       GovernanceRecordType `protobuf:"varint,1,opt,name=type,proto3,enum=sf.firecosmos.type.v1.GovernanceRecordType" json:"type,omitempty"`
+	ProposalId uint64               `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// Proposer, depositor or voter, taken from the sender of the message when the event does not hold it
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Deposited amount, ex: `10000000uatom`
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Vote options as emitted by the chain, ex: `option:VOTE_OPTION_YES weight:"1.000000000000000000"` up to v0.46 and
+	// `[{"option":1,"weight":"1.000000000000000000"}]` from v0.47
+	Option string `protobuf:"bytes,5,opt,name=option,proto3" json:"option,omitempty"`
+	// Type of the submitted proposal, up to v0.45
+	ProposalType string `protobuf:"bytes,6,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty"`
+	// The proposal entered its voting period with the submission or deposit
+	VotingPeriodStart bool `protobuf:"varint,7,opt,name=voting_period_start,json=votingPeriodStart,proto3" json:"voting_period_start,omitempty"`
+	// Result of the ended period, ex: `proposal_passed`, `proposal_rejected`, `proposal_failed`, `proposal_dropped`
+	Result  string `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+	TxHash  []byte `protobuf:"bytes,9,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxIndex uint32 `protobuf:"varint,10,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// Index of the message emitting the event in the transaction, -1 for the end block events and when unknown
+	MsgIndex int32 `protobuf:"varint,11,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+}
+
+func (x *GovernanceRecord) Reset() {
+	*x = GovernanceRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_gov_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GovernanceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovernanceRecord) ProtoMessage() {}
+
+func (x *GovernanceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_gov_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GovernanceRecord.ProtoReflect.Descriptor instead.
+func (*GovernanceRecord) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_gov_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GovernanceRecord) GetType() GovernanceRecordType {
+	if x != nil {
+		return x.Type
+	}
+	return GovernanceRecordType_GOVERNANCE_RECORD_TYPE_UNSPECIFIED
+}
+
+func (x *GovernanceRecord) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *GovernanceRecord) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GovernanceRecord) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *GovernanceRecord) GetOption() string {
+	if x != nil {
+		return x.Option
+	}
+	return ""
+}
+
+func (x *GovernanceRecord) GetProposalType() string {
+	if x != nil {
+		return x.ProposalType
+	}
+	return ""
+}
+
+func (x *GovernanceRecord) GetVotingPeriodStart() bool {
+	if x != nil {
+		return x.VotingPeriodStart
+	}
+	return false
+}
+
+func (x *GovernanceRecord) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *GovernanceRecord) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *GovernanceRecord) GetTxIndex() uint32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *GovernanceRecord) GetMsgIndex() int32 {
+	if x != nil {
+		return x.MsgIndex
+	}
+	return 0
+}
+
+var File_sf_firecosmos_type_v1_gov_proto protoreflect.FileDescriptor
+
+var file_sf_firecosmos_type_v1_gov_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x15, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x47, 0x6f,
+	0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x66, 0x2e,
+	0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xfc, 0x02, 0x0a,
+	0x10, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2b, 0x2e, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2a, 0x8c, 0x02, 0x0a, 0x14,
+	0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x47, 0x4f, 0x56, 0x45, 0x52, 0x4e, 0x41, 0x4e,
+	0x43, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26,
+	0x47, 0x4f, 0x56, 0x45, 0x52, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52,
+	0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x52,
+	0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x47, 0x4f, 0x56, 0x45,
+	0x52, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b,
+	0x47, 0x4f, 0x56, 0x45, 0x52, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52,
+	0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x03, 0x12, 0x2c, 0x0a,
+	0x28, 0x47, 0x4f, 0x56, 0x45, 0x52, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f,
+	0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50,
+	0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x2d, 0x0a, 0x29, 0x47,
+	0x4f, 0x56, 0x45, 0x52, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x50, 0x45,
+	0x52, 0x49, 0x4f, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x05, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72,
+	0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x3b,
+	0x70, 0x62, 0x66, 0x63, 0x74, 0x79, 0x70, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sf_firecosmos_type_v1_gov_proto_rawDescOnce sync.Once
+	file_sf_firecosmos_type_v1_gov_proto_rawDescData = file_sf_firecosmos_type_v1_gov_proto_rawDesc
+)
+
+func file_sf_firecosmos_type_v1_gov_proto_rawDescGZIP() []byte {
+	file_sf_firecosmos_type_v1_gov_proto_rawDescOnce.Do(func() {
+		file_sf_firecosmos_type_v1_gov_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_firecosmos_type_v1_gov_proto_rawDescData)
+	})
+	return file_sf_firecosmos_type_v1_gov_proto_rawDescData
+}
+
+var file_sf_firecosmos_type_v1_gov_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sf_firecosmos_type_v1_gov_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sf_firecosmos_type_v1_gov_proto_goTypes = []interface{}{
+	(GovernanceRecordType)(0),     // 0: sf.firecosmos.type.v1.GovernanceRecordType
+	(*GovernanceBlock)(nil),       // 1: sf.firecosmos.type.v1.GovernanceBlock
+	(*GovernanceRecord)(nil),      // 2: sf.firecosmos.type.v1.GovernanceRecord
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_sf_firecosmos_type_v1_gov_proto_depIdxs = []int32{
+	3, // 0: sf.firecosmos.type.v1.GovernanceBlock.time:type_name -> google.protobuf.Timestamp
+	2, // 1: sf.firecosmos.type.v1.GovernanceBlock.records:type_name -> sf.firecosmos.type.v1.GovernanceRecord
+	0, // 2: sf.firecosmos.type.v1.GovernanceRecord.type:type_name -> sf.firecosmos.type.v1.GovernanceRecordType
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_sf_firecosmos_type_v1_gov_proto_init() }
+func file_sf_firecosmos_type_v1_gov_proto_init() {
+	if File_sf_firecosmos_type_v1_gov_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sf_firecosmos_type_v1_gov_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GovernanceBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_type_v1_gov_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GovernanceRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sf_firecosmos_type_v1_gov_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_firecosmos_type_v1_gov_proto_goTypes,
+		DependencyIndexes: file_sf_firecosmos_type_v1_gov_proto_depIdxs,
+		EnumInfos:         file_sf_firecosmos_type_v1_gov_proto_enumTypes,
+		MessageInfos:      file_sf_firecosmos_type_v1_gov_proto_msgTypes,
+	}.Build()
+	File_sf_firecosmos_type_v1_gov_proto = out.File
+	file_sf_firecosmos_type_v1_gov_proto_rawDesc = nil
+	file_sf_firecosmos_type_v1_gov_proto_goTypes = nil
+	file_sf_firecosmos_type_v1_gov_proto_depIdxs = nil
+}
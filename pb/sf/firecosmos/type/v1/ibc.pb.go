@@ -0,0 +1,513 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: sf/firecosmos/type/v1/ibc.proto
+
+package pbfctype
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IbcPacketStage int32
+
+const (
+	IbcPacketStage_IBC_PACKET_STAGE_UNSPECIFIED           IbcPacketStage = 0
+	IbcPacketStage_IBC_PACKET_STAGE_SEND                  IbcPacketStage = 1
+	IbcPacketStage_IBC_PACKET_STAGE_RECV                  IbcPacketStage = 2
+	IbcPacketStage_IBC_PACKET_STAGE_WRITE_ACKNOWLEDGEMENT IbcPacketStage = 3
+	IbcPacketStage_IBC_PACKET_STAGE_ACKNOWLEDGE           IbcPacketStage = 4
+	IbcPacketStage_IBC_PACKET_STAGE_TIMEOUT               IbcPacketStage = 5
+)
+
+// Enum value maps for IbcPacketStage.
+var (
+	IbcPacketStage_name = map[int32]string{
+		0: "IBC_PACKET_STAGE_UNSPECIFIED",
+		1: "IBC_PACKET_STAGE_SEND",
+		2: "IBC_PACKET_STAGE_RECV",
+		3: "IBC_PACKET_STAGE_WRITE_ACKNOWLEDGEMENT",
+		4: "IBC_PACKET_STAGE_ACKNOWLEDGE",
+		5: "IBC_PACKET_STAGE_TIMEOUT",
+	}
+	IbcPacketStage_value = map[string]int32{
+		"IBC_PACKET_STAGE_UNSPECIFIED":           0,
+		"IBC_PACKET_STAGE_SEND":                  1,
+		"IBC_PACKET_STAGE_RECV":                  2,
+		"IBC_PACKET_STAGE_WRITE_ACKNOWLEDGEMENT": 3,
+		"IBC_PACKET_STAGE_ACKNOWLEDGE":           4,
+		"IBC_PACKET_STAGE_TIMEOUT":               5,
+	}
+)
+
+func (x IbcPacketStage) Enum() *IbcPacketStage {
+	p := new(IbcPacketStage)
+	*p = x
+	return p
+}
+
+func (x IbcPacketStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IbcPacketStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_firecosmos_type_v1_ibc_proto_enumTypes[0].Descriptor()
+}
+
+func (IbcPacketStage) Type() protoreflect.EnumType {
+	return &file_sf_firecosmos_type_v1_ibc_proto_enumTypes[0]
+}
+
+func (x IbcPacketStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IbcPacketStage.Descriptor instead.
+func (IbcPacketStage) EnumDescriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_ibc_proto_rawDescGZIP(), []int{0}
+}
+
+// IbcPacketBlock holds the IBC packets events of a block, normalized as one record per packet and stage
+type IbcPacketBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height  uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash    []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Packets []*IbcPacket           `protobuf:"bytes,4,rep,name=packets,proto3" json:"packets,omitempty"`
+}
+
+func (x *IbcPacketBlock) Reset() {
+	*x = IbcPacketBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_ibc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IbcPacketBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IbcPacketBlock) ProtoMessage() {}
+
+func (x *IbcPacketBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_ibc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IbcPacketBlock.ProtoReflect.Descriptor instead.
+func (*IbcPacketBlock) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_ibc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IbcPacketBlock) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *IbcPacketBlock) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *IbcPacketBlock) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *IbcPacketBlock) GetPackets() []*IbcPacket {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+type IbcPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stage              IbcPacketStage `protobuf:"varint,1,opt,name=stage,proto3,enum=sf.firecosmos.type.v1.IbcPacketStage" json:"stage,omitempty"`
+	Sequence           uint64         `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	SourcePort         string         `protobuf:"bytes,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	SourceChannel      string         `protobuf:"bytes,4,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	DestinationPort    string         `protobuf:"bytes,5,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	DestinationChannel string         `protobuf:"bytes,6,opt,name=destination_channel,json=destinationChannel,proto3" json:"destination_channel,omitempty"`
+	Connection         string         `protobuf:"bytes,7,opt,name=connection,proto3" json:"connection,omitempty"`
+	// Fungible token transfer details, from the packet data or from the events of the transfer module
+	Denom    string `protobuf:"bytes,8,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount   string `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sender   string `protobuf:"bytes,10,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string `protobuf:"bytes,11,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Memo     string `protobuf:"bytes,12,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Raw packet data, only present on send, recv and write acknowledgement stages
+	Data string `protobuf:"bytes,13,opt,name=data,proto3" json:"data,omitempty"`
+	// Timeout height formatted as `{revision}-{height}`
+	TimeoutHeight    string `protobuf:"bytes,14,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	TimeoutTimestamp uint64 `protobuf:"varint,15,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// Acknowledgement written by the receiving chain, present on write acknowledgement and acknowledge stages
+	Acknowledgement string `protobuf:"bytes,16,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+	// Transaction in which the packet event was emitted, empty for events emitted in begin or end block
+	TxHash  []byte `protobuf:"bytes,17,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxIndex uint32 `protobuf:"varint,18,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// Index of the message that emitted the packet event, -1 when unknown or outside of a message
+	MsgIndex int32 `protobuf:"varint,19,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+}
+
+func (x *IbcPacket) Reset() {
+	*x = IbcPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_ibc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IbcPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IbcPacket) ProtoMessage() {}
+
+func (x *IbcPacket) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_ibc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IbcPacket.ProtoReflect.Descriptor instead.
+func (*IbcPacket) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_ibc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IbcPacket) GetStage() IbcPacketStage {
+	if x != nil {
+		return x.Stage
+	}
+	return IbcPacketStage_IBC_PACKET_STAGE_UNSPECIFIED
+}
+
+func (x *IbcPacket) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *IbcPacket) GetSourcePort() string {
+	if x != nil {
+		return x.SourcePort
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetSourceChannel() string {
+	if x != nil {
+		return x.SourceChannel
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetDestinationPort() string {
+	if x != nil {
+		return x.DestinationPort
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetDestinationChannel() string {
+	if x != nil {
+		return x.DestinationChannel
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetConnection() string {
+	if x != nil {
+		return x.Connection
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetTimeoutHeight() string {
+	if x != nil {
+		return x.TimeoutHeight
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetTimeoutTimestamp() uint64 {
+	if x != nil {
+		return x.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (x *IbcPacket) GetAcknowledgement() string {
+	if x != nil {
+		return x.Acknowledgement
+	}
+	return ""
+}
+
+func (x *IbcPacket) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *IbcPacket) GetTxIndex() uint32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *IbcPacket) GetMsgIndex() int32 {
+	if x != nil {
+		return x.MsgIndex
+	}
+	return 0
+}
+
+var File_sf_firecosmos_type_v1_ibc_proto protoreflect.FileDescriptor
+
+var file_sf_firecosmos_type_v1_ibc_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x62, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x15, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x01, 0x0a, 0x0e, 0x49, 0x62,
+	0x63, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x66, 0x2e, 0x66,
+	0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x62, 0x63, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x22, 0x81, 0x05, 0x0a, 0x09, 0x49, 0x62, 0x63, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x62, 0x63, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2f,
+	0x0a, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a,
+	0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x73, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x73, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2a, 0xd4, 0x01, 0x0a, 0x0e, 0x49, 0x62, 0x63,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x49,
+	0x42, 0x43, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x49, 0x42, 0x43, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x47,
+	0x45, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x42, 0x43, 0x5f,
+	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x43,
+	0x56, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26, 0x49, 0x42, 0x43, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x41, 0x43,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12,
+	0x20, 0x0a, 0x1c, 0x49, 0x42, 0x43, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x10,
+	0x04, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x42, 0x43, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x05, 0x42,
+	0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x66, 0x69, 0x72, 0x65,
+	0x68, 0x6f, 0x73, 0x65, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x73,
+	0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x66, 0x63, 0x74, 0x79, 0x70, 0x65, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sf_firecosmos_type_v1_ibc_proto_rawDescOnce sync.Once
+	file_sf_firecosmos_type_v1_ibc_proto_rawDescData = file_sf_firecosmos_type_v1_ibc_proto_rawDesc
+)
+
+func file_sf_firecosmos_type_v1_ibc_proto_rawDescGZIP() []byte {
+	file_sf_firecosmos_type_v1_ibc_proto_rawDescOnce.Do(func() {
+		file_sf_firecosmos_type_v1_ibc_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_firecosmos_type_v1_ibc_proto_rawDescData)
+	})
+	return file_sf_firecosmos_type_v1_ibc_proto_rawDescData
+}
+
+var file_sf_firecosmos_type_v1_ibc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sf_firecosmos_type_v1_ibc_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sf_firecosmos_type_v1_ibc_proto_goTypes = []interface{}{
+	(IbcPacketStage)(0),           // 0: sf.firecosmos.type.v1.IbcPacketStage
+	(*IbcPacketBlock)(nil),        // 1: sf.firecosmos.type.v1.IbcPacketBlock
+	(*IbcPacket)(nil),             // 2: sf.firecosmos.type.v1.IbcPacket
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_sf_firecosmos_type_v1_ibc_proto_depIdxs = []int32{
+	3, // 0: sf.firecosmos.type.v1.IbcPacketBlock.time:type_name -> google.protobuf.Timestamp
+	2, // 1: sf.firecosmos.type.v1.IbcPacketBlock.packets:type_name -> sf.firecosmos.type.v1.IbcPacket
+	0, // 2: sf.firecosmos.type.v1.IbcPacket.stage:type_name -> sf.firecosmos.type.v1.IbcPacketStage
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_sf_firecosmos_type_v1_ibc_proto_init() }
+func file_sf_firecosmos_type_v1_ibc_proto_init() {
+	if File_sf_firecosmos_type_v1_ibc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sf_firecosmos_type_v1_ibc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IbcPacketBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_type_v1_ibc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IbcPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sf_firecosmos_type_v1_ibc_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_firecosmos_type_v1_ibc_proto_goTypes,
+		DependencyIndexes: file_sf_firecosmos_type_v1_ibc_proto_depIdxs,
+		EnumInfos:         file_sf_firecosmos_type_v1_ibc_proto_enumTypes,
+		MessageInfos:      file_sf_firecosmos_type_v1_ibc_proto_msgTypes,
+	}.Build()
+	File_sf_firecosmos_type_v1_ibc_proto = out.File
+	file_sf_firecosmos_type_v1_ibc_proto_rawDesc = nil
+	file_sf_firecosmos_type_v1_ibc_proto_goTypes = nil
+	file_sf_firecosmos_type_v1_ibc_proto_depIdxs = nil
+}
@@ -0,0 +1,488 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: sf/firecosmos/type/v1/staking.proto
+
+package pbfctype
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StakingRecordType int32
+
+const (
+	StakingRecordType_STAKING_RECORD_TYPE_UNSPECIFIED StakingRecordType = 0
+	// A validator was created with its self delegation (`create_validator` event)
+	StakingRecordType_STAKING_RECORD_TYPE_CREATE_VALIDATOR StakingRecordType = 1
+	// Tokens were delegated to a validator (`delegate` event)
+	StakingRecordType_STAKING_RECORD_TYPE_DELEGATE StakingRecordType = 2
+	// Tokens started unbonding from a validator (`unbond` event)
+	StakingRecordType_STAKING_RECORD_TYPE_UNDELEGATE StakingRecordType = 3
+	// Tokens were redelegated from source_validator to validator (`redelegate` event)
+	StakingRecordType_STAKING_RECORD_TYPE_REDELEGATE StakingRecordType = 4
+	// An unbonding delegation was canceled and delegated again (`cancel_unbonding_delegation` event), from v0.46
+	StakingRecordType_STAKING_RECORD_TYPE_CANCEL_UNDELEGATION StakingRecordType = 5
+	// An unbonding delegation matured (`complete_unbonding` end block event)
+	StakingRecordType_STAKING_RECORD_TYPE_COMPLETE_UNDELEGATION StakingRecordType = 6
+	// A redelegation matured (`complete_redelegation` end block event)
+	StakingRecordType_STAKING_RECORD_TYPE_COMPLETE_REDELEGATION StakingRecordType = 7
+	// Delegation rewards were withdrawn (`withdraw_rewards` event)
+	StakingRecordType_STAKING_RECORD_TYPE_WITHDRAW_REWARDS StakingRecordType = 8
+	// Validator commission was withdrawn (`withdraw_commission` event)
+	StakingRecordType_STAKING_RECORD_TYPE_WITHDRAW_COMMISSION StakingRecordType = 9
+	// Rewards were allocated to a validator and its delegators (`rewards` begin block event)
+	StakingRecordType_STAKING_RECORD_TYPE_REWARDS StakingRecordType = 10
+	// Commission was allocated to a validator (`commission` begin block event)
+	StakingRecordType_STAKING_RECORD_TYPE_COMMISSION StakingRecordType = 11
+	// The block proposer reward was allocated to a validator (`proposer_reward` begin block event), up to v0.46
+	StakingRecordType_STAKING_RECORD_TYPE_PROPOSER_REWARD StakingRecordType = 12
+)
+
+// Enum value maps for StakingRecordType.
+var (
+	StakingRecordType_name = map[int32]string{
+		0:  "STAKING_RECORD_TYPE_UNSPECIFIED",
+		1:  "STAKING_RECORD_TYPE_CREATE_VALIDATOR",
+		2:  "STAKING_RECORD_TYPE_DELEGATE",
+		3:  "STAKING_RECORD_TYPE_UNDELEGATE",
+		4:  "STAKING_RECORD_TYPE_REDELEGATE",
+		5:  "STAKING_RECORD_TYPE_CANCEL_UNDELEGATION",
+		6:  "STAKING_RECORD_TYPE_COMPLETE_UNDELEGATION",
+		7:  "STAKING_RECORD_TYPE_COMPLETE_REDELEGATION",
+		8:  "STAKING_RECORD_TYPE_WITHDRAW_REWARDS",
+		9:  "STAKING_RECORD_TYPE_WITHDRAW_COMMISSION",
+		10: "STAKING_RECORD_TYPE_REWARDS",
+		11: "STAKING_RECORD_TYPE_COMMISSION",
+		12: "STAKING_RECORD_TYPE_PROPOSER_REWARD",
+	}
+	StakingRecordType_value = map[string]int32{
+		"STAKING_RECORD_TYPE_UNSPECIFIED":           0,
+		"STAKING_RECORD_TYPE_CREATE_VALIDATOR":      1,
+		"STAKING_RECORD_TYPE_DELEGATE":              2,
+		"STAKING_RECORD_TYPE_UNDELEGATE":            3,
+		"STAKING_RECORD_TYPE_REDELEGATE":            4,
+		"STAKING_RECORD_TYPE_CANCEL_UNDELEGATION":   5,
+		"STAKING_RECORD_TYPE_COMPLETE_UNDELEGATION": 6,
+		"STAKING_RECORD_TYPE_COMPLETE_REDELEGATION": 7,
+		"STAKING_RECORD_TYPE_WITHDRAW_REWARDS":      8,
+		"STAKING_RECORD_TYPE_WITHDRAW_COMMISSION":   9,
+		"STAKING_RECORD_TYPE_REWARDS":               10,
+		"STAKING_RECORD_TYPE_COMMISSION":            11,
+		"STAKING_RECORD_TYPE_PROPOSER_REWARD":       12,
+	}
+)
+
+func (x StakingRecordType) Enum() *StakingRecordType {
+	p := new(StakingRecordType)
+	*p = x
+	return p
+}
+
+func (x StakingRecordType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StakingRecordType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_firecosmos_type_v1_staking_proto_enumTypes[0].Descriptor()
+}
+
+func (StakingRecordType) Type() protoreflect.EnumType {
+	return &file_sf_firecosmos_type_v1_staking_proto_enumTypes[0]
+}
+
+func (x StakingRecordType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StakingRecordType.Descriptor instead.
+func (StakingRecordType) EnumDescriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_staking_proto_rawDescGZIP(), []int{0}
+}
+
+// StakingBlock holds the staking and distribution events of a block, from its begin blocker, transactions and end
+// blocker, normalized as one record per event
+type StakingBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height  uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash    []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Records []*StakingRecord       `protobuf:"bytes,4,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *StakingBlock) Reset() {
+	*x = StakingBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_staking_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingBlock) ProtoMessage() {}
+
+func (x *StakingBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_staking_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingBlock.ProtoReflect.Descriptor instead.
+func (*StakingBlock) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_staking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StakingBlock) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *StakingBlock) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *StakingBlock) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *StakingBlock) GetRecords() []*StakingRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type StakingRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type StakingRecordType `protobuf:"varint,1,opt,name=type,proto3,enum=sf.firecosmos.type.v1.StakingRecordType" json:"type,omitempty"`
+	// Delegator account, taken from the sender of the message when the event does not hold it, the validator account
+	// for the self delegation of created validators, empty for the records of validators
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// Validator operator address, the destination validator of redelegations
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Source validator operator address of redelegations
+	SourceValidator string `protobuf:"bytes,4,opt,name=source_validator,json=sourceValidator,proto3" json:"source_validator,omitempty"`
+	// Amount as emitted by the chain, ex: `1000uatom` for delegations and `102.345000000000000000uatom` for the rewards
+	// and commission allocated in the begin blocker. Delegations and unbondings emitted up to v0.46 only hold the integer
+	// amount of the bond denom, ex: `1000`.
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Maturity time of undelegations and redelegations, as emitted by the chain
+	CompletionTime string      `protobuf:"bytes,6,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	Origin         EventOrigin `protobuf:"varint,7,opt,name=origin,proto3,enum=sf.firecosmos.type.v1.EventOrigin" json:"origin,omitempty"`
+	TxHash         []byte      `protobuf:"bytes,8,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxIndex        uint32      `protobuf:"varint,9,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// Index of the message emitting the event in the transaction, -1 for the begin and end block events and when unknown
+	MsgIndex int32 `protobuf:"varint,10,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+}
+
+func (x *StakingRecord) Reset() {
+	*x = StakingRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_firecosmos_type_v1_staking_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingRecord) ProtoMessage() {}
+
+func (x *StakingRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_firecosmos_type_v1_staking_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingRecord.ProtoReflect.Descriptor instead.
+func (*StakingRecord) Descriptor() ([]byte, []int) {
+	return file_sf_firecosmos_type_v1_staking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StakingRecord) GetType() StakingRecordType {
+	if x != nil {
+		return x.Type
+	}
+	return StakingRecordType_STAKING_RECORD_TYPE_UNSPECIFIED
+}
+
+func (x *StakingRecord) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *StakingRecord) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *StakingRecord) GetSourceValidator() string {
+	if x != nil {
+		return x.SourceValidator
+	}
+	return ""
+}
+
+func (x *StakingRecord) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *StakingRecord) GetCompletionTime() string {
+	if x != nil {
+		return x.CompletionTime
+	}
+	return ""
+}
+
+func (x *StakingRecord) GetOrigin() EventOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return EventOrigin_EVENT_ORIGIN_UNSPECIFIED
+}
+
+func (x *StakingRecord) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *StakingRecord) GetTxIndex() uint32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *StakingRecord) GetMsgIndex() int32 {
+	if x != nil {
+		return x.MsgIndex
+	}
+	return 0
+}
+
+var File_sf_firecosmos_type_v1_staking_proto protoreflect.FileDescriptor
+
+var file_sf_firecosmos_type_v1_staking_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x73,
+	0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xaa, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x3e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22,
+	0x82, 0x03, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x3c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x28, 0x2e, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x66, 0x2e, 0x66, 0x69, 0x72,
+	0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x2a, 0x9c, 0x04, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x54,
+	0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x28, 0x0a, 0x24, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52,
+	0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x41,
+	0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x53,
+	0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12,
+	0x22, 0x0a, 0x1e, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52,
+	0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54,
+	0x45, 0x10, 0x04, 0x12, 0x2b, 0x0a, 0x27, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52,
+	0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05,
+	0x12, 0x2d, 0x0a, 0x29, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x43, 0x4f,
+	0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
+	0x5f, 0x55, 0x4e, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12,
+	0x2d, 0x0a, 0x29, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52,
+	0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x5f,
+	0x52, 0x45, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x28,
+	0x0a, 0x24, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x5f, 0x52,
+	0x45, 0x57, 0x41, 0x52, 0x44, 0x53, 0x10, 0x08, 0x12, 0x2b, 0x0a, 0x27, 0x53, 0x54, 0x41, 0x4b,
+	0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x10, 0x09, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47,
+	0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x57,
+	0x41, 0x52, 0x44, 0x53, 0x10, 0x0a, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e,
+	0x47, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f,
+	0x4d, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x27, 0x0a, 0x23, 0x53, 0x54,
+	0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52,
+	0x44, 0x10, 0x0c, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x70, 0x62, 0x2f, 0x73, 0x66, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x66, 0x63, 0x74, 0x79, 0x70,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sf_firecosmos_type_v1_staking_proto_rawDescOnce sync.Once
+	file_sf_firecosmos_type_v1_staking_proto_rawDescData = file_sf_firecosmos_type_v1_staking_proto_rawDesc
+)
+
+func file_sf_firecosmos_type_v1_staking_proto_rawDescGZIP() []byte {
+	file_sf_firecosmos_type_v1_staking_proto_rawDescOnce.Do(func() {
+		file_sf_firecosmos_type_v1_staking_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_firecosmos_type_v1_staking_proto_rawDescData)
+	})
+	return file_sf_firecosmos_type_v1_staking_proto_rawDescData
+}
+
+var file_sf_firecosmos_type_v1_staking_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sf_firecosmos_type_v1_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sf_firecosmos_type_v1_staking_proto_goTypes = []interface{}{
+	(StakingRecordType)(0),        // 0: sf.firecosmos.type.v1.StakingRecordType
+	(*StakingBlock)(nil),          // 1: sf.firecosmos.type.v1.StakingBlock
+	(*StakingRecord)(nil),         // 2: sf.firecosmos.type.v1.StakingRecord
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(EventOrigin)(0),              // 4: sf.firecosmos.type.v1.EventOrigin
+}
+var file_sf_firecosmos_type_v1_staking_proto_depIdxs = []int32{
+	3, // 0: sf.firecosmos.type.v1.StakingBlock.time:type_name -> google.protobuf.Timestamp
+	2, // 1: sf.firecosmos.type.v1.StakingBlock.records:type_name -> sf.firecosmos.type.v1.StakingRecord
+	0, // 2: sf.firecosmos.type.v1.StakingRecord.type:type_name -> sf.firecosmos.type.v1.StakingRecordType
+	4, // 3: sf.firecosmos.type.v1.StakingRecord.origin:type_name -> sf.firecosmos.type.v1.EventOrigin
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_sf_firecosmos_type_v1_staking_proto_init() }
+func file_sf_firecosmos_type_v1_staking_proto_init() {
+	if File_sf_firecosmos_type_v1_staking_proto != nil {
+		return
+	}
+	file_sf_firecosmos_type_v1_balance_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_sf_firecosmos_type_v1_staking_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_firecosmos_type_v1_staking_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sf_firecosmos_type_v1_staking_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_firecosmos_type_v1_staking_proto_goTypes,
+		DependencyIndexes: file_sf_firecosmos_type_v1_staking_proto_depIdxs,
+		EnumInfos:         file_sf_firecosmos_type_v1_staking_proto_enumTypes,
+		MessageInfos:      file_sf_firecosmos_type_v1_staking_proto_msgTypes,
+	}.Build()
+	File_sf_firecosmos_type_v1_staking_proto = out.File
+	file_sf_firecosmos_type_v1_staking_proto_rawDesc = nil
+	file_sf_firecosmos_type_v1_staking_proto_goTypes = nil
+	file_sf_firecosmos_type_v1_staking_proto_depIdxs = nil
+}
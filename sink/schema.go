@@ -0,0 +1,164 @@
+package sink
+
+import (
+	"fmt"
+	"strings"
+)
+
+// table is a table of the schema, rows being upserted on their primary key
+type table struct {
+	name       string
+	columns    []string
+	primaryKey []string
+	// heightColumn is the block height column, used to delete the rows of the blocks which are undone
+	heightColumn string
+}
+
+var (
+	blocksTable = &table{
+		name:         "blocks",
+		columns:      []string{"height", "hash", "time", "chain_id", "proposer_address", "tx_count", "event_count"},
+		primaryKey:   []string{"height"},
+		heightColumn: "height",
+	}
+	transactionsTable = &table{
+		name:         "transactions",
+		columns:      []string{"block_height", "index", "hash", "code", "codespace", "gas_wanted", "gas_used", "gas_limit", "fee", "payer", "granter", "memo", "message_count", "event_count"},
+		primaryKey:   []string{"block_height", "index"},
+		heightColumn: "block_height",
+	}
+	messagesTable = &table{
+		name:         "messages",
+		columns:      []string{"block_height", "tx_index", "index", "tx_hash", "type_url", "json"},
+		primaryKey:   []string{"block_height", "tx_index", "index"},
+		heightColumn: "block_height",
+	}
+	eventsTable = &table{
+		name:         "events",
+		columns:      []string{"id", "block_height", "origin", "tx_index", "tx_hash", "msg_index", "index", "type"},
+		primaryKey:   []string{"id"},
+		heightColumn: "block_height",
+	}
+	attributesTable = &table{
+		name:         "attributes",
+		columns:      []string{"event_id", "index", "block_height", "key", "value", "indexed"},
+		primaryKey:   []string{"event_id", "index"},
+		heightColumn: "block_height",
+	}
+
+	// dataTables are the tables holding the blocks data, children first
+	dataTables = []*table{attributesTable, eventsTable, messagesTable, transactionsTable, blocksTable}
+)
+
+// schema returns the statements creating the tables and indexes which do not exist yet
+func schema(d *dialect) []string {
+	return []string{
+		`CREATE TABLE IF NOT EXISTS cursors (
+			id TEXT PRIMARY KEY,
+			cursor TEXT NOT NULL,
+			block_num BIGINT NOT NULL,
+			block_hash TEXT NOT NULL
+		)`,
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS blocks (
+			height BIGINT PRIMARY KEY,
+			hash TEXT NOT NULL,
+			"time" %s NOT NULL,
+			chain_id TEXT NOT NULL,
+			proposer_address TEXT NOT NULL,
+			tx_count INTEGER NOT NULL,
+			event_count INTEGER NOT NULL
+		)`, d.timestamp),
+		`CREATE TABLE IF NOT EXISTS transactions (
+			block_height BIGINT NOT NULL,
+			"index" INTEGER NOT NULL,
+			hash TEXT NOT NULL,
+			code BIGINT NOT NULL,
+			codespace TEXT NOT NULL,
+			gas_wanted BIGINT NOT NULL,
+			gas_used BIGINT NOT NULL,
+			gas_limit BIGINT NOT NULL,
+			fee TEXT NOT NULL,
+			payer TEXT NOT NULL,
+			granter TEXT NOT NULL,
+			memo TEXT NOT NULL,
+			message_count INTEGER NOT NULL,
+			event_count INTEGER NOT NULL,
+			PRIMARY KEY (block_height, "index")
+		)`,
+		`CREATE INDEX IF NOT EXISTS transactions_hash_idx ON transactions (hash)`,
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS messages (
+			block_height BIGINT NOT NULL,
+			tx_index INTEGER NOT NULL,
+			"index" INTEGER NOT NULL,
+			tx_hash TEXT NOT NULL,
+			type_url TEXT NOT NULL,
+			json %s NOT NULL,
+			PRIMARY KEY (block_height, tx_index, "index")
+		)`, d.json),
+		`CREATE INDEX IF NOT EXISTS messages_type_url_idx ON messages (type_url, block_height)`,
+		`CREATE TABLE IF NOT EXISTS events (
+			id TEXT PRIMARY KEY,
+			block_height BIGINT NOT NULL,
+			origin TEXT NOT NULL,
+			tx_index INTEGER,
+			tx_hash TEXT,
+			msg_index INTEGER,
+			"index" INTEGER NOT NULL,
+			"type" TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS events_block_height_idx ON events (block_height)`,
+		`CREATE INDEX IF NOT EXISTS events_type_idx ON events ("type", block_height)`,
+		`CREATE TABLE IF NOT EXISTS attributes (
+			event_id TEXT NOT NULL,
+			"index" INTEGER NOT NULL,
+			block_height BIGINT NOT NULL,
+			"key" TEXT NOT NULL,
+			"value" TEXT NOT NULL,
+			indexed BOOLEAN NOT NULL,
+			PRIMARY KEY (event_id, "index")
+		)`,
+		`CREATE INDEX IF NOT EXISTS attributes_block_height_idx ON attributes (block_height)`,
+		`CREATE INDEX IF NOT EXISTS attributes_key_value_idx ON attributes ("key", "value")`,
+	}
+}
+
+// upsertQuery returns the query inserting a row in the table, or updating it when its primary key exists
+func (t *table) upsertQuery() string {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(t.columns)), ", ")
+
+	isKey := make(map[string]bool)
+	for _, column := range t.primaryKey {
+		isKey[column] = true
+	}
+	var updates []string
+	for _, column := range t.columns {
+		if !isKey[column] {
+			updates = append(updates, fmt.Sprintf("%s = excluded.%s", quote(column), quote(column)))
+		}
+	}
+
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) DO UPDATE SET %s",
+		t.name, quoteAll(t.columns), placeholders, quoteAll(t.primaryKey), strings.Join(updates, ", "))
+}
+
+// deleteFromQuery returns the query deleting the rows of the blocks at or above a height
+func (t *table) deleteFromQuery() string {
+	return fmt.Sprintf("DELETE FROM %s WHERE %s >= ?", t.name, t.heightColumn)
+}
+
+// quote quotes the column names which are keywords
+func quote(column string) string {
+	switch column {
+	case "index", "time", "type", "key", "value":
+		return `"` + column + `"`
+	}
+	return column
+}
+
+func quoteAll(columns []string) string {
+	out := make([]string, len(columns))
+	for i, column := range columns {
+		out[i] = quote(column)
+	}
+	return strings.Join(out, ", ")
+}
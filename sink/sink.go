@@ -0,0 +1,260 @@
+// Package sink writes the blocks, transactions, messages, events and attributes of the Cosmos blocks read from a
+// firehose stream into the tables of a SQLite or Postgres database, written by `tools sink`
+package sink
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/graphprotocol/firehose-cosmos/export"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+// Cursor is the position of the stream once the rows of a block were committed
+type Cursor struct {
+	Cursor    string
+	BlockNum  uint64
+	BlockHash string
+}
+
+// Sink upserts the rows of the blocks, the final ones being committed by batches. The rows of a block replace the
+// ones of the blocks at or above its height, so that replaying blocks is idempotent and forked blocks are replaced.
+type Sink struct {
+	db        *sql.DB
+	dialect   *dialect
+	id        string
+	batchSize int
+
+	rows          *export.Rows
+	pendingCount  int
+	pendingStart  uint64
+	pendingCursor *Cursor
+}
+
+// Open connects to the database of a `sqlite://` or `postgres://` DSN, the cursor being saved under id. Up to
+// batchSize final blocks are committed at once.
+func Open(dsn string, id string, batchSize int) (*Sink, error) {
+	if batchSize < 1 {
+		return nil, fmt.Errorf("invalid batch size %d, expecting at least 1", batchSize)
+	}
+	d, source, err := parseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open(d.driver, source)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s database: %w", d.name, err)
+	}
+	if d == sqliteDialect {
+		// SQLite allows a single writer
+		db.SetMaxOpenConns(1)
+	}
+
+	return &Sink{
+		db:        db,
+		dialect:   d,
+		id:        id,
+		batchSize: batchSize,
+		rows:      &export.Rows{},
+	}, nil
+}
+
+// Setup creates the tables and indexes which do not exist yet
+func (s *Sink) Setup(ctx context.Context) error {
+	for _, statement := range schema(s.dialect) {
+		if _, err := s.db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("creating schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// Cursor returns the saved cursor, nil when no block was committed yet
+func (s *Sink) Cursor(ctx context.Context) (*Cursor, error) {
+	cursor := &Cursor{}
+	row := s.db.QueryRowContext(ctx, s.dialect.rebind("SELECT cursor, block_num, block_hash FROM cursors WHERE id = ?"), s.id)
+	if err := row.Scan(&cursor.Cursor, &cursor.BlockNum, &cursor.BlockHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading cursor %q: %w", s.id, err)
+	}
+	return cursor, nil
+}
+
+// HandleBlock adds the rows of a block, committed with its cursor right away unless the block is final and the
+// batch is not full
+func (s *Sink) HandleBlock(ctx context.Context, block *pbcosmos.Block, cursor string, final bool) error {
+	if block.Header == nil {
+		return fmt.Errorf("block has no header")
+	}
+	if s.pendingCount != 0 && block.Header.Height <= s.pendingCursor.BlockNum {
+		// The batch is replaced from this height on, the rows are always appended in height order
+		if err := s.Flush(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := s.rows.Append(block); err != nil {
+		return fmt.Errorf("block %d: %w", block.Header.Height, err)
+	}
+	if s.pendingCount == 0 {
+		s.pendingStart = block.Header.Height
+	}
+	s.pendingCount++
+	s.pendingCursor = blockCursor(block, cursor)
+
+	if !final || s.pendingCount >= s.batchSize {
+		return s.Flush(ctx)
+	}
+	return nil
+}
+
+// HandleUndo deletes the rows of an undone block and of the ones above it, saving the cursor
+func (s *Sink) HandleUndo(ctx context.Context, block *pbcosmos.Block, cursor string) error {
+	if block.Header == nil {
+		return fmt.Errorf("block has no header")
+	}
+	if err := s.Flush(ctx); err != nil {
+		return err
+	}
+	return s.commit(ctx, block.Header.Height, blockCursor(block, cursor))
+}
+
+// Flush commits the pending blocks
+func (s *Sink) Flush(ctx context.Context) error {
+	if s.pendingCount == 0 {
+		return nil
+	}
+	if err := s.commit(ctx, s.pendingStart, s.pendingCursor); err != nil {
+		return err
+	}
+	s.rows.Reset()
+	s.pendingCount = 0
+	s.pendingCursor = nil
+	return nil
+}
+
+// Close flushes the pending blocks and closes the database
+func (s *Sink) Close(ctx context.Context) error {
+	flushErr := s.Flush(ctx)
+	if err := s.db.Close(); err != nil && flushErr == nil {
+		return fmt.Errorf("closing database: %w", err)
+	}
+	return flushErr
+}
+
+// commit replaces the rows of the blocks at or above fromHeight by the pending rows and saves the cursor, in a
+// single transaction
+func (s *Sink) commit(ctx context.Context, fromHeight uint64, cursor *Cursor) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, t := range dataTables {
+		if _, err := tx.ExecContext(ctx, s.dialect.rebind(t.deleteFromQuery()), int64(fromHeight)); err != nil {
+			return fmt.Errorf("deleting %s from height %d: %w", t.name, fromHeight, err)
+		}
+	}
+
+	// Parents first
+	for i := len(dataTables) - 1; i >= 0; i-- {
+		t := dataTables[i]
+		if err := s.upsert(ctx, tx, t, tableValues(s.dialect, t, s.rows)); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, s.dialect.rebind(`INSERT INTO cursors (id, cursor, block_num, block_hash) VALUES (?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET cursor = excluded.cursor, block_num = excluded.block_num, block_hash = excluded.block_hash`),
+		s.id, cursor.Cursor, int64(cursor.BlockNum), cursor.BlockHash)
+	if err != nil {
+		return fmt.Errorf("saving cursor %q: %w", s.id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *Sink) upsert(ctx context.Context, tx *sql.Tx, t *table, values [][]interface{}) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	stmt, err := tx.PrepareContext(ctx, s.dialect.rebind(t.upsertQuery()))
+	if err != nil {
+		return fmt.Errorf("preparing %s upsert: %w", t.name, err)
+	}
+	defer stmt.Close()
+
+	for _, args := range values {
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			return fmt.Errorf("upserting %s row: %w", t.name, err)
+		}
+	}
+	return nil
+}
+
+// tableValues returns the values of the rows of a table, in the order of its columns, the text values being
+// sanitized for the dialect
+func tableValues(d *dialect, t *table, rows *export.Rows) [][]interface{} {
+	var out [][]interface{}
+	switch t {
+	case blocksTable:
+		for _, r := range rows.Blocks {
+			out = append(out, []interface{}{r.Height, r.Hash, millisTime(r.Time), r.ChainID, r.ProposerAddress, r.TxCount, r.EventCount})
+		}
+	case transactionsTable:
+		for _, r := range rows.Transactions {
+			out = append(out, []interface{}{r.BlockHeight, r.Index, r.Hash, r.Code, r.Codespace, r.GasWanted, r.GasUsed, r.GasLimit, r.Fee, r.Payer, r.Granter, r.Memo, r.MessageCount, r.EventCount})
+		}
+	case messagesTable:
+		for _, r := range rows.Messages {
+			out = append(out, []interface{}{r.BlockHeight, r.TxIndex, r.Index, r.TxHash, r.TypeURL, r.JSON})
+		}
+	case eventsTable:
+		for _, r := range rows.Events {
+			out = append(out, []interface{}{r.ID, r.BlockHeight, r.Origin, r.TxIndex, r.TxHash, r.MsgIndex, r.Index, r.Type})
+		}
+	case attributesTable:
+		for _, r := range rows.Attributes {
+			out = append(out, []interface{}{r.EventID, r.Index, r.BlockHeight, r.Key, r.Value, r.Indexed})
+		}
+	}
+
+	for _, values := range out {
+		for i, value := range values {
+			switch v := value.(type) {
+			case string:
+				values[i] = d.text(v)
+			case *string:
+				if v != nil {
+					values[i] = d.text(*v)
+				}
+			}
+		}
+	}
+	return out
+}
+
+func blockCursor(block *pbcosmos.Block, cursor string) *Cursor {
+	return &Cursor{
+		Cursor:    cursor,
+		BlockNum:  block.Header.Height,
+		BlockHash: strings.ToUpper(hex.EncodeToString(block.Header.Hash)),
+	}
+}
+
+func millisTime(ms int64) time.Time {
+	return time.UnixMilli(ms).UTC()
+}
@@ -0,0 +1,242 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/export"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func event(eventType string, attributes ...string) *pbcosmos.Event {
+	out := &pbcosmos.Event{EventType: eventType}
+	for i := 0; i+1 < len(attributes); i += 2 {
+		out.Attributes = append(out.Attributes, &pbcosmos.EventAttribute{Key: []byte(attributes[i]), Value: []byte(attributes[i+1]), Index: true})
+	}
+	return out
+}
+
+// testBlock returns a block with a transaction of one message, the memo and hash telling the forks apart
+func testBlock(height uint64, hash byte, memo string) *pbcosmos.Block {
+	return &pbcosmos.Block{
+		Header: &pbcosmos.Header{
+			ChainId: "cosmoshub-4",
+			Height:  height,
+			Hash:    []byte{hash},
+			Time:    &pbcosmos.Timestamp{Seconds: 1663000000 + int64(height)},
+		},
+		ResultBeginBlock: &pbcosmos.ResponseBeginBlock{Events: []*pbcosmos.Event{event("mint", "amount", "7000000uatom")}},
+		Transactions: []*pbcosmos.TxResult{{
+			Hash: []byte{hash, 0xff},
+			Tx: &pbcosmos.Tx{
+				Body: &pbcosmos.TxBody{
+					Messages: []*anypb.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{0x0a, 0x02, 'a', 'b'}}},
+					Memo:     memo,
+				},
+			},
+			Result: &pbcosmos.ResponseDeliverTx{
+				Events: []*pbcosmos.Event{event("transfer", "recipient", "cosmos1to", "msg_index", "0")},
+			},
+		}},
+	}
+}
+
+func testCursor(height uint64, hash byte) string {
+	return fmt.Sprintf("c%d-%x", height, hash)
+}
+
+func openTestSink(t *testing.T, batchSize int) *Sink {
+	t.Helper()
+	s, err := Open("sqlite://"+filepath.Join(t.TempDir(), "sink.db"), "test", batchSize)
+	require.NoError(t, err)
+	t.Cleanup(func() { s.db.Close() })
+
+	require.NoError(t, s.Setup(context.Background()))
+	return s
+}
+
+func count(t *testing.T, s *Sink, table string) int {
+	t.Helper()
+	var out int
+	require.NoError(t, s.db.QueryRow("SELECT COUNT(*) FROM "+table).Scan(&out))
+	return out
+}
+
+func memos(t *testing.T, s *Sink) []string {
+	t.Helper()
+	rows, err := s.db.Query("SELECT memo FROM transactions ORDER BY block_height")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var out []string
+	for rows.Next() {
+		var memo string
+		require.NoError(t, rows.Scan(&memo))
+		out = append(out, memo)
+	}
+	require.NoError(t, rows.Err())
+	return out
+}
+
+func TestParseDSN(t *testing.T) {
+	d, source, err := parseDSN("sqlite://./sink.db")
+	require.NoError(t, err)
+	assert.Equal(t, sqliteDialect, d)
+	assert.Equal(t, "./sink.db?_time_format=sqlite", source)
+
+	d, source, err = parseDSN("postgres://localhost:5432/cosmos?sslmode=disable")
+	require.NoError(t, err)
+	assert.Equal(t, postgresDialect, d)
+	assert.Equal(t, "postgres://localhost:5432/cosmos?sslmode=disable", source)
+
+	_, _, err = parseDSN("sqlite://")
+	require.Error(t, err)
+	_, _, err = parseDSN("mysql://localhost/cosmos")
+	require.Error(t, err)
+}
+
+func TestDialect_Rebind(t *testing.T) {
+	query := "DELETE FROM events WHERE block_height >= ? AND id = ?"
+	assert.Equal(t, query, sqliteDialect.rebind(query))
+	assert.Equal(t, "DELETE FROM events WHERE block_height >= $1 AND id = $2", postgresDialect.rebind(query))
+}
+
+func TestSink_Setup(t *testing.T) {
+	s := openTestSink(t, 1)
+	ctx := context.Background()
+
+	// The schema creation is idempotent
+	require.NoError(t, s.Setup(ctx))
+
+	cursor, err := s.Cursor(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+func TestTableValues_Text(t *testing.T) {
+	block := testBlock(10, 0x0a, "memo\x00")
+	block.Transactions[0].Result.Events = append(block.Transactions[0].Result.Events,
+		&pbcosmos.Event{EventType: "binary", Attributes: []*pbcosmos.EventAttribute{{Key: []byte("data"), Value: []byte{'a', 0x00, 0xff, 'b'}}}})
+	rows := &export.Rows{}
+	require.NoError(t, rows.Append(block))
+
+	// The Postgres text values cannot hold NUL characters or invalid UTF-8
+	attributes := tableValues(postgresDialect, attributesTable, rows)
+	require.Len(t, attributes, 4)
+	assert.Equal(t, []interface{}{"10/DeliverTx/0/1", int32(0), int64(10), "data", "a\uFFFDb", false}, attributes[3])
+	assert.Equal(t, "memo", tableValues(postgresDialect, transactionsTable, rows)[0][11])
+
+	attributes = tableValues(sqliteDialect, attributesTable, rows)
+	assert.Equal(t, "a\x00\xffb", attributes[3][4])
+	assert.Equal(t, "memo\x00", tableValues(sqliteDialect, transactionsTable, rows)[0][11])
+}
+
+func TestSink_HandleBlock(t *testing.T) {
+	s := openTestSink(t, 1)
+	ctx := context.Background()
+
+	require.NoError(t, s.HandleBlock(ctx, testBlock(10, 0x0a, "ten"), testCursor(10, 0x0a), true))
+	require.NoError(t, s.HandleBlock(ctx, testBlock(11, 0x0b, "eleven"), testCursor(11, 0x0b), true))
+
+	assert.Equal(t, 2, count(t, s, "blocks"))
+	assert.Equal(t, 2, count(t, s, "transactions"))
+	assert.Equal(t, 2, count(t, s, "messages"))
+	assert.Equal(t, 4, count(t, s, "events"))
+	assert.Equal(t, 6, count(t, s, "attributes"))
+
+	var hash, txHash, attrValue string
+	var msgIndex int
+	require.NoError(t, s.db.QueryRow(`SELECT e.tx_hash, e.msg_index, a."value" FROM events e JOIN attributes a ON a.event_id = e.id
+		WHERE e.block_height = 11 AND e."type" = 'transfer' AND a."key" = 'recipient'`).Scan(&txHash, &msgIndex, &attrValue))
+	assert.Equal(t, "0BFF", txHash)
+	assert.Equal(t, 0, msgIndex)
+	assert.Equal(t, "cosmos1to", attrValue)
+
+	var blockTime string
+	var beginBlockTxHash *string
+	require.NoError(t, s.db.QueryRow(`SELECT hash, datetime("time") FROM blocks WHERE height = 10`).Scan(&hash, &blockTime))
+	require.NoError(t, s.db.QueryRow(`SELECT tx_hash FROM events WHERE block_height = 10 AND origin = 'BeginBlock'`).Scan(&beginBlockTxHash))
+	assert.Equal(t, "0A", hash)
+	assert.Equal(t, "2022-09-12 16:26:50", blockTime)
+	assert.Nil(t, beginBlockTxHash)
+
+	cursor, err := s.Cursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &Cursor{Cursor: testCursor(11, 0x0b), BlockNum: 11, BlockHash: "0B"}, cursor)
+
+	// Replaying the blocks is idempotent
+	require.NoError(t, s.HandleBlock(ctx, testBlock(10, 0x0a, "ten"), testCursor(10, 0x0a), true))
+	require.NoError(t, s.HandleBlock(ctx, testBlock(11, 0x0b, "eleven"), testCursor(11, 0x0b), true))
+	assert.Equal(t, 2, count(t, s, "blocks"))
+	assert.Equal(t, 6, count(t, s, "attributes"))
+	assert.Equal(t, []string{"ten", "eleven"}, memos(t, s))
+}
+
+func TestSink_Fork(t *testing.T) {
+	s := openTestSink(t, 1)
+	ctx := context.Background()
+
+	require.NoError(t, s.HandleBlock(ctx, testBlock(10, 0x0a, "ten"), testCursor(10, 0x0a), false))
+	require.NoError(t, s.HandleBlock(ctx, testBlock(11, 0x0b, "eleven"), testCursor(11, 0x0b), false))
+	require.NoError(t, s.HandleBlock(ctx, testBlock(12, 0x0c, "twelve"), testCursor(12, 0x0c), false))
+
+	// A block of the fork replaces the blocks at or above its height
+	require.NoError(t, s.HandleBlock(ctx, testBlock(11, 0x1b, "eleven bis"), testCursor(11, 0x1b), false))
+	assert.Equal(t, []string{"ten", "eleven bis"}, memos(t, s))
+	assert.Equal(t, 2, count(t, s, "blocks"))
+	assert.Equal(t, 4, count(t, s, "events"))
+
+	// Undoing a block deletes its rows and the ones above it
+	require.NoError(t, s.HandleUndo(ctx, testBlock(11, 0x1b, "eleven bis"), testCursor(11, 0x1b)))
+	assert.Equal(t, []string{"ten"}, memos(t, s))
+	assert.Equal(t, 1, count(t, s, "blocks"))
+	assert.Equal(t, 1, count(t, s, "messages"))
+	assert.Equal(t, 3, count(t, s, "attributes"))
+
+	cursor, err := s.Cursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &Cursor{Cursor: testCursor(11, 0x1b), BlockNum: 11, BlockHash: "1B"}, cursor)
+}
+
+func TestSink_Batch(t *testing.T) {
+	s := openTestSink(t, 3)
+	ctx := context.Background()
+
+	require.NoError(t, s.HandleBlock(ctx, testBlock(10, 0x0a, "ten"), testCursor(10, 0x0a), true))
+	require.NoError(t, s.HandleBlock(ctx, testBlock(11, 0x0b, "eleven"), testCursor(11, 0x0b), true))
+	assert.Equal(t, 0, count(t, s, "blocks"))
+
+	cursor, err := s.Cursor(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	// The batch is committed once full
+	require.NoError(t, s.HandleBlock(ctx, testBlock(12, 0x0c, "twelve"), testCursor(12, 0x0c), true))
+	assert.Equal(t, 3, count(t, s, "blocks"))
+
+	// A block which is not final commits the pending ones
+	require.NoError(t, s.HandleBlock(ctx, testBlock(13, 0x0d, "thirteen"), testCursor(13, 0x0d), true))
+	assert.Equal(t, 3, count(t, s, "blocks"))
+	require.NoError(t, s.HandleBlock(ctx, testBlock(14, 0x0e, "fourteen"), testCursor(14, 0x0e), false))
+	assert.Equal(t, 5, count(t, s, "blocks"))
+
+	require.NoError(t, s.HandleBlock(ctx, testBlock(15, 0x0f, "fifteen"), testCursor(15, 0x0f), true))
+	require.NoError(t, s.Flush(ctx))
+	assert.Equal(t, []string{"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen"}, memos(t, s))
+
+	cursor, err = s.Cursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &Cursor{Cursor: testCursor(15, 0x0f), BlockNum: 15, BlockHash: "0F"}, cursor)
+}
+
+func TestSink_Upsert(t *testing.T) {
+	assert.Equal(t,
+		`INSERT INTO attributes (event_id, "index", block_height, "key", "value", indexed) VALUES (?, ?, ?, ?, ?, ?) ON CONFLICT (event_id, "index") DO UPDATE SET block_height = excluded.block_height, "key" = excluded."key", "value" = excluded."value", indexed = excluded.indexed`,
+		attributesTable.upsertQuery(),
+	)
+}
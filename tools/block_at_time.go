@@ -0,0 +1,68 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/graphprotocol/firehose-cosmos/blocktime"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/dstore"
+)
+
+var blockAtTimeCmd = &cobra.Command{
+	Use:   "block-at-time {blocks-url} {rfc3339-time}",
+	Short: "Print the first block produced at or after the given time",
+	Long: `Print the first block produced at or after the given time.
+
+When --index-url is set, the block time index files (short name "blocktime", written by the indexer app or
+generate-indexes) are binary searched, the blocks that are not indexed are binary searched in the merged
+blocks files. The same lookup is served by firehose as the sf.firecosmos.blocktime.v1.BlockTime gRPC service.`,
+	Args:    cobra.ExactArgs(2),
+	RunE:    blockAtTimeE,
+	PreRunE: initFirstStreamable,
+	Example: "firecosmos tools block-at-time gs://my-bucket/merged-blocks 2022-09-12T16:00:00Z --index-url gs://my-bucket/indexes",
+}
+
+func init() {
+	blockAtTimeCmd.Flags().String("index-url", "", "Store holding the block time index files, only the merged blocks are searched when empty")
+
+	Cmd.AddCommand(blockAtTimeCmd)
+}
+
+func blockAtTimeE(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	blocksStoreURL := args[0]
+	t, err := time.Parse(time.RFC3339Nano, args[1])
+	if err != nil {
+		return fmt.Errorf("invalid time %q, expected RFC3339 format (ex: 2022-09-12T16:00:00Z): %w", args[1], err)
+	}
+
+	blocksStore, err := dstore.NewDBinStore(blocksStoreURL)
+	if err != nil {
+		return fmt.Errorf("failed setting up block store from url %q: %w", blocksStoreURL, err)
+	}
+
+	var indexStore dstore.Store
+	if indexStoreURL := mustGetString(cmd, "index-url"); indexStoreURL != "" {
+		indexStore, err = dstore.NewStore(indexStoreURL, "", "", false)
+		if err != nil {
+			return fmt.Errorf("failed setting up an index store from url %q: %w", indexStoreURL, err)
+		}
+	}
+	cmd.SilenceUsage = true
+
+	blk, err := blocktime.NewResolver(blocksStore, indexStore, nil).BlockAt(ctx, t)
+	if err != nil {
+		if errors.Is(err, blocktime.ErrNotFound) {
+			return fmt.Errorf("no block found at or after %s", t.UTC().Format(time.RFC3339Nano))
+		}
+		return err
+	}
+
+	fmt.Printf("Block #%d (%s)\n", blk.Num, blk.Time.UTC().Format(time.RFC3339Nano))
+	return nil
+}
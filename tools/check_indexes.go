@@ -0,0 +1,316 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	"github.com/graphprotocol/firehose-cosmos/transform"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/dstore"
+	sftools "github.com/streamingfast/sf-tools"
+	"go.uber.org/zap"
+)
+
+var checkIndexesCmd = &cobra.Command{
+	Use:   "indexes {index-url} {blocks-url}",
+	Short: "Reports index coverage per short name and size, and verifies a sample of index files against the blocks",
+	Long: `Reports index coverage per short name and size, and verifies a sample of index files against the blocks.
+
+For each index short name and size, the covered ranges are listed along with the gaps between them and the
+index files overlapping each other. The files of a sharded index (ex: txhash) are grouped per bundle, the
+bundles missing some of their shard files being reported as incomplete. For --samples index bundles of each
+short name and size, a random merged blocks bundle within the range of the bundle is read and the keys of
+each block are derived again using the registered indexer, they must match the index bitmaps exactly. The
+files of the index store which are not index files are listed. The command fails if any mismatch is found.`,
+	Args: cobra.ExactArgs(2),
+	RunE: checkIndexesE,
+}
+
+func init() {
+	checkIndexesCmd.Flags().Int("samples", 5, "Number of index bundles verified against the blocks for each index short name and size")
+	checkIndexesCmd.Flags().Int64("seed", 0, "Seed used to pick the verified index files and bundles, defaults to the current time")
+
+	CheckCmd.AddCommand(checkIndexesCmd)
+}
+
+type indexGroup struct {
+	shortName string
+	size      uint64
+	files     []transform.IndexFile
+
+	ranges     []sftools.BlockRange // Stop is exclusive
+	gaps       []sftools.BlockRange // Stop is exclusive
+	overlaps   []string
+	incomplete []string // bundles of a sharded index missing some of their shard files
+
+	sampled    int
+	mismatches []string
+}
+
+func checkIndexesE(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	indexStoreURL := args[0]
+	blocksStoreURL := args[1]
+
+	blockRange, err := sftools.Flags.GetBlockRange("range")
+	if err != nil {
+		return err
+	}
+	samples, err := cmd.Flags().GetInt("samples")
+	if err != nil {
+		return err
+	}
+	seed := mustGetInt64(cmd, "seed")
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	indexStore, err := dstore.NewStore(indexStoreURL, "", "", false)
+	if err != nil {
+		return fmt.Errorf("failed setting up an index store from url %q: %w", indexStoreURL, err)
+	}
+	mergedBlocksStore, err := dstore.NewDBinStore(blocksStoreURL)
+	if err != nil {
+		return fmt.Errorf("failed setting up block store from url %q: %w", blocksStoreURL, err)
+	}
+	cmd.SilenceUsage = true
+
+	files, unknown, err := transform.ListIndexFiles(ctx, indexStore)
+	if err != nil {
+		return fmt.Errorf("listing index files: %w", err)
+	}
+	printUnknownFiles(unknown)
+
+	groups := groupIndexFiles(files, blockRange)
+	if len(groups) == 0 {
+		fmt.Println("No index files found")
+		return nil
+	}
+
+	registered := make(map[string]bool)
+	for _, shortName := range transform.RegisteredIndexers() {
+		registered[shortName] = true
+	}
+
+	zlog.Info("verifying index samples", zap.Int64("seed", seed))
+	random := rand.New(rand.NewSource(seed))
+	for _, group := range groups {
+		group.computeCoverage()
+
+		if !registered[group.shortName] {
+			zlog.Warn("no indexer registered for index, skipping verification", zap.String("short_name", group.shortName))
+			continue
+		}
+		if err := group.verifySamples(ctx, mergedBlocksStore, indexStore, samples, random); err != nil {
+			return err
+		}
+	}
+
+	printIndexGroups(groups)
+
+	mismatchCount := 0
+	for _, group := range groups {
+		mismatchCount += len(group.mismatches)
+	}
+	if mismatchCount > 0 {
+		return fmt.Errorf("found %d index mismatches", mismatchCount)
+	}
+	return nil
+}
+
+// groupIndexFiles splits the sorted index files by short name and size, keeping only the files
+// intersecting blockRange
+func groupIndexFiles(files []transform.IndexFile, blockRange sftools.BlockRange) (out []*indexGroup) {
+	var current *indexGroup
+	for _, f := range files {
+		if f.StopBlockNum() <= blockRange.Start || (!blockRange.Unbounded() && f.BaseBlockNum >= blockRange.Stop) {
+			continue
+		}
+		if current == nil || current.shortName != f.ShortName || current.size != f.Size {
+			current = &indexGroup{shortName: f.ShortName, size: f.Size}
+			out = append(out, current)
+		}
+		current.files = append(current.files, f)
+	}
+	return out
+}
+
+// bundles splits the sorted files of the group per base block num, the files of all the shards of a sharded index
+// covering the same blocks
+func (g *indexGroup) bundles() (out [][]transform.IndexFile) {
+	for i := 0; i < len(g.files); {
+		j := i + 1
+		for j < len(g.files) && g.files[i].Shard != "" && g.files[j].BaseBlockNum == g.files[i].BaseBlockNum {
+			j++
+		}
+		out = append(out, g.files[i:j])
+		i = j
+	}
+	return out
+}
+
+func (g *indexGroup) computeCoverage() {
+	shardCount := len(transform.IndexShards(g.shortName))
+	for _, bundle := range g.bundles() {
+		f := bundle[0]
+		if shardCount > 0 && len(bundle) != shardCount {
+			g.incomplete = append(g.incomplete, fmt.Sprintf("%s has %d of its %d shard files", f.Name(), len(bundle), shardCount))
+		}
+		if f.BaseBlockNum%g.size != 0 {
+			g.overlaps = append(g.overlaps, fmt.Sprintf("%s is not aligned on its size", f.Filename()))
+		}
+
+		if len(g.ranges) == 0 {
+			g.ranges = append(g.ranges, sftools.BlockRange{Start: f.BaseBlockNum, Stop: f.StopBlockNum()})
+			continue
+		}
+
+		last := &g.ranges[len(g.ranges)-1]
+		switch {
+		case f.BaseBlockNum < last.Stop:
+			g.overlaps = append(g.overlaps, fmt.Sprintf("%s overlaps range [%d, %d)", f.Filename(), last.Start, last.Stop))
+			if f.StopBlockNum() > last.Stop {
+				last.Stop = f.StopBlockNum()
+			}
+		case f.BaseBlockNum == last.Stop:
+			last.Stop = f.StopBlockNum()
+		default:
+			g.gaps = append(g.gaps, sftools.BlockRange{Start: last.Stop, Stop: f.BaseBlockNum})
+			g.ranges = append(g.ranges, sftools.BlockRange{Start: f.BaseBlockNum, Stop: f.StopBlockNum()})
+		}
+	}
+}
+
+// verifySamples derives the keys of the blocks of a random merged blocks bundle for up to samples
+// index bundles of the group and compares them with the bitmaps of the files of those bundles
+func (g *indexGroup) verifySamples(ctx context.Context, mergedBlocksStore, indexStore dstore.Store, samples int, random *rand.Rand) error {
+	candidates := g.bundles()
+	random.Shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
+
+	for _, bundle := range candidates {
+		if g.sampled >= samples {
+			return nil
+		}
+		f := bundle[0]
+
+		firstBundle := bundleBaseNum(f.BaseBlockNum)
+		bundleCount := (bundleBaseNum(f.StopBlockNum()-1)-firstBundle)/codec.MergedBundleSize + 1
+		baseNum := firstBundle + uint64(random.Int63n(int64(bundleCount)))*codec.MergedBundleSize
+
+		blocks, err := readMergedBundle(ctx, mergedBlocksStore, baseNum)
+		if err != nil {
+			if errors.Is(err, dstore.ErrNotFound) {
+				zlog.Warn("merged blocks file not found, skipping index bundle", zap.String("index_file", f.Name()), zap.Uint64("base_block_num", baseNum))
+				continue
+			}
+			return err
+		}
+
+		// The keys of the shards of a bundle are distinct
+		bitmaps := make(map[string]*roaring64.Bitmap)
+		for _, shardFile := range bundle {
+			shardBitmaps, err := readIndexFile(ctx, indexStore, shardFile)
+			if err != nil {
+				return err
+			}
+			for key, bitmap := range shardBitmaps {
+				bitmaps[key] = bitmap
+			}
+		}
+
+		for _, blk := range blocks {
+			if blk.Number < f.BaseBlockNum || blk.Number >= f.StopBlockNum() {
+				continue
+			}
+
+			keys, err := transform.IndexKeys(g.shortName, blk.ToProtocol().(*pbcosmos.Block))
+			if err != nil {
+				return err
+			}
+			g.mismatches = append(g.mismatches, compareIndexKeys(f.Name(), blk.Number, keys, bitmaps)...)
+		}
+		g.sampled++
+	}
+	return nil
+}
+
+func compareIndexKeys(name string, blockNum uint64, keys []string, bitmaps map[string]*roaring64.Bitmap) (mismatches []string) {
+	expected := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		expected[key] = true
+	}
+
+	for key := range expected {
+		if bitmap, ok := bitmaps[key]; !ok || !bitmap.Contains(blockNum) {
+			mismatches = append(mismatches, fmt.Sprintf("%s: block #%d has key %q but is not in its bitmap", name, blockNum, key))
+		}
+	}
+	for key, bitmap := range bitmaps {
+		if bitmap.Contains(blockNum) && !expected[key] {
+			mismatches = append(mismatches, fmt.Sprintf("%s: block #%d is in the bitmap of key %q but does not have that key", name, blockNum, key))
+		}
+	}
+	sort.Strings(mismatches)
+	return mismatches
+}
+
+func printIndexGroups(groups []*indexGroup) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "INDEX\tSIZE\tFILES\tCOVERED RANGES\tGAPS\tOVERLAPS\tINCOMPLETE\tSAMPLED\tMISMATCHES")
+	for _, g := range groups {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%d\t%d\t%d\t%d\t%d\n", g.shortName, g.size, len(g.files), formatBlockRanges(g.ranges), len(g.gaps), len(g.overlaps), len(g.incomplete), g.sampled, len(g.mismatches))
+	}
+	w.Flush()
+
+	for _, g := range groups {
+		if len(g.gaps) == 0 && len(g.overlaps) == 0 && len(g.incomplete) == 0 && len(g.mismatches) == 0 {
+			continue
+		}
+
+		fmt.Printf("\n%s (size %d)\n", g.shortName, g.size)
+		for _, gap := range g.gaps {
+			fmt.Printf("  gap: [%d, %d)\n", gap.Start, gap.Stop)
+		}
+		for _, overlap := range g.overlaps {
+			fmt.Printf("  overlap: %s\n", overlap)
+		}
+		for _, incomplete := range g.incomplete {
+			fmt.Printf("  incomplete: %s\n", incomplete)
+		}
+		for _, mismatch := range g.mismatches {
+			fmt.Printf("  mismatch: %s\n", mismatch)
+		}
+	}
+}
+
+func printUnknownFiles(filenames []string) {
+	if len(filenames) == 0 {
+		return
+	}
+
+	fmt.Printf("Found %d files which are not index files\n", len(filenames))
+	for _, filename := range filenames {
+		fmt.Printf("  unknown: %s\n", filename)
+	}
+	fmt.Println()
+}
+
+func formatBlockRanges(ranges []sftools.BlockRange) string {
+	parts := make([]string, len(ranges))
+	for i, r := range ranges {
+		parts[i] = fmt.Sprintf("[%d, %d)", r.Start, r.Stop)
+	}
+	return strings.Join(parts, " ")
+}
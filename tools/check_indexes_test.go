@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	"github.com/graphprotocol/firehose-cosmos/transform"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dstore"
+	sftools "github.com/streamingfast/sf-tools"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckIndexes_ShardedIndex(t *testing.T) {
+	ctx := context.Background()
+	indexStore, err := dstore.NewStore(t.TempDir(), "", "", false)
+	require.NoError(t, err)
+	blocksStore, err := dstore.NewDBinStore(t.TempDir())
+	require.NoError(t, err)
+
+	indexer, err := transform.NewTxHashIndexer(indexStore, 100, 0)
+	require.NoError(t, err)
+	var blocks []*bstream.Block
+	for height := uint64(0); height <= 200; height++ {
+		block := &pbcosmos.Block{Header: &pbcosmos.Header{
+			Height: height,
+			Hash:   []byte{byte(height)},
+			Time:   &pbcosmos.Timestamp{Seconds: 1663000000 + int64(height)},
+		}}
+		if height%10 == 0 {
+			block.Transactions = []*pbcosmos.TxResult{{Index: 0, Hash: []byte{byte(height), 0x01, 0x02, 0x03}}}
+		}
+		indexer.ProcessBlock(block)
+
+		if height < 100 {
+			blk, err := codec.FromProto(block)
+			require.NoError(t, err)
+			blocks = append(blocks, blk)
+		}
+	}
+	require.NoError(t, writeMergedBundle(ctx, blocksStore, 0, blocks))
+	// The second bundle is missing one of its shard files
+	require.NoError(t, indexStore.DeleteObject(ctx, "txhash/9D/0000000100.100.txhash.idx"))
+
+	files, unknown, err := transform.ListIndexFiles(ctx, indexStore)
+	require.NoError(t, err)
+	assert.Empty(t, unknown)
+	assert.Len(t, files, 511)
+
+	groups := groupIndexFiles(files, sftools.BlockRange{Start: 0})
+	require.Len(t, groups, 1)
+	group := groups[0]
+
+	group.computeCoverage()
+	assert.Equal(t, []sftools.BlockRange{{Start: 0, Stop: 200}}, group.ranges)
+	assert.Empty(t, group.gaps)
+	assert.Empty(t, group.overlaps)
+	assert.Equal(t, []string{"0000000100.100.txhash.idx has 255 of its 256 shard files"}, group.incomplete)
+
+	// The bundle without merged blocks is skipped
+	require.NoError(t, group.verifySamples(ctx, blocksStore, indexStore, 2, rand.New(rand.NewSource(1))))
+	assert.Equal(t, 1, group.sampled)
+	assert.Empty(t, group.mismatches)
+}
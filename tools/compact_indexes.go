@@ -0,0 +1,183 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/graphprotocol/firehose-cosmos/transform"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+var compactIndexesCmd = &cobra.Command{
+	Use:   "compact-indexes {index-url}",
+	Short: "Merge contiguous index files of --source-size into index files of --target-size",
+	Long: `Merge contiguous index files of --source-size into index files of --target-size.
+
+A target index file is written only when every source index file it covers is present, so the bundle
+at the head of the chain is left untouched until it is complete. Existing target index files are kept.
+Run the command again with other sizes to compact further (ex: 1000 to 10000, then 10000 to 100000),
+firehose looks up the sizes listed in common-block-index-sizes, largest first. The files of the sharded
+indexes (ex: txhash) are compacted within their shard.`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    compactIndexesE,
+	Example: "firecosmos tools compact-indexes gs://my-bucket/indexes --source-size 1000 --target-size 10000 --delete-source",
+}
+
+func init() {
+	compactIndexesCmd.Flags().Uint64("source-size", 1000, "Size of the index files to merge")
+	compactIndexesCmd.Flags().Uint64("target-size", 10000, "Size of the index files to create, must be a multiple of source-size")
+	compactIndexesCmd.Flags().StringSlice("indexes", nil, "Short names of the indexes to compact, defaults to all the ones found in the store")
+	compactIndexesCmd.Flags().Bool("delete-source", false, "Delete the source index files once covered by a target index file")
+	compactIndexesCmd.Flags().Int("parallel", 4, "Number of target index files written concurrently")
+
+	Cmd.AddCommand(compactIndexesCmd)
+}
+
+type indexCompaction struct {
+	target  transform.IndexFile
+	sources []transform.IndexFile
+	exists  bool // the target index file is already present in the store
+}
+
+func compactIndexesE(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	indexStoreURL := args[0]
+	sourceSize := mustGetUint64(cmd, "source-size")
+	targetSize := mustGetUint64(cmd, "target-size")
+	if sourceSize == 0 || targetSize <= sourceSize || targetSize%sourceSize != 0 {
+		return fmt.Errorf("target-size %d must be a multiple of source-size %d, greater than it", targetSize, sourceSize)
+	}
+	shortNames, err := cmd.Flags().GetStringSlice("indexes")
+	if err != nil {
+		return err
+	}
+	deleteSource := mustGetBool(cmd, "delete-source")
+	parallel, err := cmd.Flags().GetInt("parallel")
+	if err != nil {
+		return err
+	}
+	if parallel < 1 {
+		return fmt.Errorf("invalid parallel value %d, must be at least 1", parallel)
+	}
+
+	indexStore, err := dstore.NewStore(indexStoreURL, "", "", false)
+	if err != nil {
+		return fmt.Errorf("failed setting up an index store from url %q: %w", indexStoreURL, err)
+	}
+	cmd.SilenceUsage = true
+
+	files, _, err := transform.ListIndexFiles(ctx, indexStore)
+	if err != nil {
+		return fmt.Errorf("listing index files: %w", err)
+	}
+
+	compactions := planIndexCompactions(files, shortNames, sourceSize, targetSize)
+	zlog.Info("planned index compactions", zap.Int("target_count", len(compactions)))
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(parallel)
+	for _, compaction := range compactions {
+		compaction := compaction
+		eg.Go(func() error {
+			return compactIndexFiles(egCtx, indexStore, compaction, deleteSource)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	zlog.Info("complete")
+	return nil
+}
+
+// planIndexCompactions returns the target index files for which every source index file is present.
+// An empty shortNames selects every index found.
+func planIndexCompactions(files []transform.IndexFile, shortNames []string, sourceSize, targetSize uint64) (out []*indexCompaction) {
+	selected := make(map[string]bool)
+	for _, shortName := range shortNames {
+		selected[shortName] = true
+	}
+
+	existing := make(map[transform.IndexFile]bool)
+	for _, f := range files {
+		existing[f] = true
+	}
+
+	expected := targetSize / sourceSize
+	byTarget := make(map[transform.IndexFile]*indexCompaction)
+	for _, f := range files {
+		if f.Size != sourceSize || f.BaseBlockNum%sourceSize != 0 {
+			continue
+		}
+		if len(selected) > 0 && !selected[f.ShortName] {
+			continue
+		}
+
+		target := transform.IndexFile{
+			BaseBlockNum: f.BaseBlockNum - f.BaseBlockNum%targetSize,
+			Size:         targetSize,
+			ShortName:    f.ShortName,
+			Shard:        f.Shard,
+		}
+		compaction, ok := byTarget[target]
+		if !ok {
+			compaction = &indexCompaction{target: target, exists: existing[target]}
+			byTarget[target] = compaction
+			out = append(out, compaction)
+		}
+		compaction.sources = append(compaction.sources, f)
+	}
+
+	complete := out[:0]
+	for _, compaction := range out {
+		if uint64(len(compaction.sources)) != expected {
+			zlog.Info("skipping incomplete index bundle", zap.String("target", compaction.target.Filename()), zap.Int("source_count", len(compaction.sources)))
+			continue
+		}
+		complete = append(complete, compaction)
+	}
+	return complete
+}
+
+func compactIndexFiles(ctx context.Context, store dstore.Store, compaction *indexCompaction, deleteSource bool) error {
+	if compaction.exists {
+		zlog.Debug("target index file already exists", zap.String("target", compaction.target.Filename()))
+	} else {
+		merged := make(map[string]*roaring64.Bitmap)
+		for _, source := range compaction.sources {
+			bitmaps, err := readIndexFile(ctx, store, source)
+			if err != nil {
+				return err
+			}
+			for key, bitmap := range bitmaps {
+				if existing, ok := merged[key]; ok {
+					existing.Or(bitmap)
+					continue
+				}
+				merged[key] = bitmap
+			}
+		}
+
+		if err := writeIndexFile(ctx, store, compaction.target, merged); err != nil {
+			return err
+		}
+		zlog.Info("wrote compacted index file", zap.String("target", compaction.target.Filename()), zap.Int("source_count", len(compaction.sources)), zap.Int("key_count", len(merged)))
+	}
+
+	if !deleteSource {
+		return nil
+	}
+	for _, source := range compaction.sources {
+		if err := store.DeleteObject(ctx, source.Filename()); err != nil {
+			return fmt.Errorf("deleting index file %q: %w", source.Filename(), err)
+		}
+	}
+	zlog.Info("deleted source index files", zap.String("target", compaction.target.Filename()), zap.Int("source_count", len(compaction.sources)))
+	return nil
+}
@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/transform"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testIndexFiles returns count consecutive index files of size starting at baseBlockNum
+func testIndexFiles(shortName, shard string, baseBlockNum, size uint64, count int) (out []transform.IndexFile) {
+	for i := 0; i < count; i++ {
+		out = append(out, transform.IndexFile{BaseBlockNum: baseBlockNum + uint64(i)*size, Size: size, ShortName: shortName, Shard: shard})
+	}
+	return out
+}
+
+func TestPlanIndexCompactions(t *testing.T) {
+	type expectedCompaction struct {
+		target      transform.IndexFile
+		sourceCount int
+		exists      bool
+	}
+
+	tests := []struct {
+		name       string
+		files      []transform.IndexFile
+		shortNames []string
+		expected   []expectedCompaction
+	}{
+		{
+			name:  "aligned complete target",
+			files: testIndexFiles("messagetype", "", 1000, 100, 10),
+			expected: []expectedCompaction{
+				{target: transform.IndexFile{BaseBlockNum: 1000, Size: 1000, ShortName: "messagetype"}, sourceCount: 10},
+			},
+		},
+		{
+			name:     "incomplete target",
+			files:    testIndexFiles("messagetype", "", 1000, 100, 9),
+			expected: nil,
+		},
+		{
+			name:  "incomplete target at the head",
+			files: testIndexFiles("messagetype", "", 0, 100, 15),
+			expected: []expectedCompaction{
+				{target: transform.IndexFile{BaseBlockNum: 0, Size: 1000, ShortName: "messagetype"}, sourceCount: 10},
+			},
+		},
+		{
+			name:     "sources not aligned on their size",
+			files:    testIndexFiles("messagetype", "", 1050, 100, 10),
+			expected: nil,
+		},
+		{
+			name: "unaligned and other sizes sources left out",
+			files: append(append(testIndexFiles("messagetype", "", 0, 100, 10),
+				transform.IndexFile{BaseBlockNum: 150, Size: 100, ShortName: "messagetype"}),
+				testIndexFiles("messagetype", "", 0, 10, 10)...),
+			expected: []expectedCompaction{
+				{target: transform.IndexFile{BaseBlockNum: 0, Size: 1000, ShortName: "messagetype"}, sourceCount: 10},
+			},
+		},
+		{
+			name: "existing target",
+			files: append(testIndexFiles("messagetype", "", 0, 100, 10),
+				transform.IndexFile{BaseBlockNum: 0, Size: 1000, ShortName: "messagetype"}),
+			expected: []expectedCompaction{
+				{target: transform.IndexFile{BaseBlockNum: 0, Size: 1000, ShortName: "messagetype"}, sourceCount: 10, exists: true},
+			},
+		},
+		{
+			name: "selected indexes",
+			files: append(testIndexFiles("messagetype", "", 0, 100, 10),
+				testIndexFiles("eventtype", "", 0, 100, 10)...),
+			shortNames: []string{"eventtype"},
+			expected: []expectedCompaction{
+				{target: transform.IndexFile{BaseBlockNum: 0, Size: 1000, ShortName: "eventtype"}, sourceCount: 10},
+			},
+		},
+		{
+			name: "shards compacted separately",
+			files: append(testIndexFiles("txhash", "00", 0, 100, 10),
+				testIndexFiles("txhash", "01", 0, 100, 9)...),
+			expected: []expectedCompaction{
+				{target: transform.IndexFile{BaseBlockNum: 0, Size: 1000, ShortName: "txhash", Shard: "00"}, sourceCount: 10},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var compactions []expectedCompaction
+			for _, compaction := range planIndexCompactions(test.files, test.shortNames, 100, 1000) {
+				for _, source := range compaction.sources {
+					assert.Equal(t, compaction.target.BaseBlockNum, source.BaseBlockNum-source.BaseBlockNum%1000)
+					assert.Equal(t, compaction.target.Shard, source.Shard)
+				}
+				compactions = append(compactions, expectedCompaction{
+					target:      compaction.target,
+					sourceCount: len(compaction.sources),
+					exists:      compaction.exists,
+				})
+			}
+			assert.Equal(t, test.expected, compactions)
+		})
+	}
+}
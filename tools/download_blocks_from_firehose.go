@@ -3,33 +3,65 @@ package tools
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+	"sync/atomic"
+	"time"
 
 	"github.com/graphprotocol/firehose-cosmos/codec"
 	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
 
 	"github.com/spf13/cobra"
 	"github.com/streamingfast/bstream"
-	sftools "github.com/streamingfast/sf-tools"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose/client"
+	pbfirehose "github.com/streamingfast/pbgo/sf/firehose/v2"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
+const (
+	// downloadMaxRetries is the number of consecutive failures of the stream of a range before giving up
+	downloadMaxRetries = 10
+	downloadRetryDelay = 5 * time.Second
+)
+
 func init() {
 	Cmd.AddCommand(DownloadFromFirehoseCmd)
 	DownloadFromFirehoseCmd.Flags().StringP("api-token-env-var", "a", "FIREHOSE_API_TOKEN", "Look for a JWT in this environment variable to authenticate against endpoint")
 	DownloadFromFirehoseCmd.Flags().BoolP("plaintext", "p", false, "Use plaintext connection to Firehose")
 	DownloadFromFirehoseCmd.Flags().BoolP("insecure", "k", false, "Skip SSL certificate validation when connecting to Firehose")
+	DownloadFromFirehoseCmd.Flags().Int("parallel", 4, "Number of ranges downloaded concurrently")
+	DownloadFromFirehoseCmd.Flags().Uint64("range-size", 10000, "Number of blocks of each range requested from Firehose, a multiple of 100")
+	DownloadFromFirehoseCmd.Flags().Bool("resume", true, "Skip the merged blocks files already in the destination store")
 }
 
 var DownloadFromFirehoseCmd = &cobra.Command{
-	Use:     "download-from-firehose",
-	Short:   "download blocks from Firehose and save them to merged-blocks",
+	Use:   "download-from-firehose {endpoint} {start-block-num} {stop-block-num} {destination-blocks-url}",
+	Short: "download blocks from Firehose and save them to merged-blocks",
+	Long: `Download blocks from Firehose and save them to merged-blocks.
+
+The merged blocks files of the bundles holding the blocks of [start-block-num, stop-block-num) are written
+to the destination store, any store URL being supported. The missing files are split in ranges of
+--range-size blocks, --parallel ranges being streamed concurrently. With --resume, the files already in the
+destination store are skipped, so that an interrupted download starts again from the first missing file.
+A stream failing is reconnected from the cursor of the last block received. The files are written at the
+version set by --common-blocks-version.`,
 	Args:    cobra.ExactArgs(4),
 	RunE:    downloadFromFirehoseE,
 	PreRunE: initFirstStreamable,
-	Example: "firecosmos tools download-from-firehose f.q.d.n:443 1000 2000 ./outputdir",
+	Example: `firecosmos tools download-from-firehose f.q.d.n:443 1000 2000 ./outputdir
+firecosmos tools download-from-firehose f.q.d.n:443 10000000 11000000 gs://my-bucket/merged-blocks --parallel 8`,
+}
+
+// downloadRange is a range of merged bundles downloaded with a single request, Stop being exclusive
+type downloadRange struct {
+	Start uint64
+	Stop  uint64
 }
 
 func downloadFromFirehoseE(cmd *cobra.Command, args []string) error {
@@ -44,27 +76,164 @@ func downloadFromFirehoseE(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("parsing stop block num: %w", err)
 	}
-	destFolder := args[3]
-
-	apiTokenEnvVar := mustGetString(cmd, "api-token-env-var")
-	apiToken := os.Getenv(apiTokenEnvVar)
-
-	plaintext := mustGetBool(cmd, "plaintext")
-	insecure := mustGetBool(cmd, "insecure")
-
-	return sftools.DownloadFirehoseBlocks(
-		ctx,
-		endpoint,
-		apiToken,
-		insecure,
-		plaintext,
-		start,
-		stop,
-		destFolder,
-		decodeAnyPB,
-		nil,
-		zlog,
-	)
+	if stop <= start {
+		return fmt.Errorf("invalid range, stop block %d is not after start block %d", stop, start)
+	}
+	parallel := mustGetInt(cmd, "parallel")
+	if parallel < 1 {
+		return fmt.Errorf("invalid parallel value %d, must be at least 1", parallel)
+	}
+	rangeSize := mustGetUint64(cmd, "range-size")
+	if rangeSize == 0 || rangeSize%codec.MergedBundleSize != 0 {
+		return fmt.Errorf("invalid range size %d, must be a multiple of %d", rangeSize, codec.MergedBundleSize)
+	}
+
+	if err := codec.SetBlockWriterVersion(mustGetInt(cmd, "common-blocks-version")); err != nil {
+		return err
+	}
+
+	store, err := dstore.NewDBinStore(args[3])
+	if err != nil {
+		return fmt.Errorf("failed setting up destination block store from url %q: %w", args[3], err)
+	}
+	cmd.SilenceUsage = true
+
+	existing := make(map[uint64]bool)
+	if mustGetBool(cmd, "resume") {
+		existing, err = listMergedBundles(ctx, store)
+		if err != nil {
+			return fmt.Errorf("listing destination merged blocks files: %w", err)
+		}
+	}
+	ranges, skipped := missingRanges(bundleBaseNum(start), stop, rangeSize, existing)
+	zlog.Info("downloading merged blocks files", zap.Int("range_count", len(ranges)), zap.Int("skipped_file_count", skipped))
+	if len(ranges) == 0 {
+		zlog.Info("complete")
+		return nil
+	}
+
+	firehoseClient, closeFunc, callOpts, err := client.NewFirehoseClient(endpoint, os.Getenv(mustGetString(cmd, "api-token-env-var")), mustGetBool(cmd, "insecure"), mustGetBool(cmd, "plaintext"))
+	if err != nil {
+		return fmt.Errorf("connecting to %q: %w", endpoint, err)
+	}
+	defer closeFunc()
+
+	var written int64
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(parallel)
+	for _, r := range ranges {
+		r := r
+		eg.Go(func() error {
+			count, err := downloadBundles(egCtx, firehoseClient, callOpts, store, r)
+			atomic.AddInt64(&written, int64(count))
+			if err != nil {
+				return fmt.Errorf("downloading range [%d, %d): %w", r.Start, r.Stop, err)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	zlog.Info("complete", zap.Int64("written_file_count", written), zap.Int("skipped_file_count", skipped))
+	return nil
+}
+
+// missingRanges groups the bundles from startBase until the one holding the block before stop which do not exist in
+// ranges of at most rangeSize blocks, returning the number of existing bundles
+func missingRanges(startBase, stop, rangeSize uint64, existing map[uint64]bool) (ranges []downloadRange, skipped int) {
+	for baseNum := startBase; baseNum < stop; baseNum += codec.MergedBundleSize {
+		if existing[baseNum] {
+			skipped++
+			continue
+		}
+		if n := len(ranges); n > 0 && ranges[n-1].Stop == baseNum && baseNum-ranges[n-1].Start < rangeSize {
+			ranges[n-1].Stop += codec.MergedBundleSize
+			continue
+		}
+		ranges = append(ranges, downloadRange{Start: baseNum, Stop: baseNum + codec.MergedBundleSize})
+	}
+	return ranges, skipped
+}
+
+// downloadBundles streams the blocks of a range and writes its merged bundles, reconnecting from the cursor of the
+// last block received when the stream fails. It returns the number of bundles written.
+func downloadBundles(ctx context.Context, firehoseClient pbfirehose.StreamClient, callOpts []grpc.CallOption, store dstore.Store, r downloadRange) (int, error) {
+	baseNum := r.Start
+	var blocks []*bstream.Block
+	written := 0
+	flush := func() error {
+		if !isCompleteBundle(baseNum, blocks) {
+			return fmt.Errorf("incomplete bundle %d, got %d blocks", baseNum, len(blocks))
+		}
+		if err := writeMergedBundle(ctx, store, baseNum, blocks); err != nil {
+			return err
+		}
+		written++
+		blocks = nil
+		return nil
+	}
+
+	cursor := ""
+	retries := 0
+	for {
+		req := &pbfirehose.Request{
+			StartBlockNum:   int64(bundleFirstBlockNum(r.Start)),
+			StopBlockNum:    r.Stop - 1,
+			FinalBlocksOnly: true,
+			Cursor:          cursor,
+		}
+		stream, err := firehoseClient.Blocks(ctx, req, callOpts...)
+		if err != nil {
+			return written, fmt.Errorf("requesting blocks: %w", err)
+		}
+
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				if len(blocks) != 0 {
+					if err := flush(); err != nil {
+						return written, err
+					}
+				}
+				if expected := int((r.Stop - r.Start) / codec.MergedBundleSize); written != expected {
+					return written, fmt.Errorf("stream ended after %d of the %d bundles", written, expected)
+				}
+				return written, nil
+			}
+			if err != nil {
+				retries++
+				if retries > downloadMaxRetries {
+					return written, fmt.Errorf("stream failed %d times in a row: %w", downloadMaxRetries, err)
+				}
+				zlog.Warn("stream failed, reconnecting from cursor", zap.Error(err), zap.Int("retry", retries), zap.Duration("delay", downloadRetryDelay))
+				select {
+				case <-ctx.Done():
+					return written, ctx.Err()
+				case <-time.After(downloadRetryDelay):
+				}
+				break
+			}
+			retries = 0
+
+			blk, err := decodeAnyPB(response.Block)
+			if err != nil {
+				return written, fmt.Errorf("error decoding response to bstream block: %w", err)
+			}
+			if blk.Number < baseNum || blk.Number >= r.Stop {
+				return written, fmt.Errorf("received block %d out of the range", blk.Number)
+			}
+			if blk.Number >= baseNum+codec.MergedBundleSize {
+				if err := flush(); err != nil {
+					return written, err
+				}
+				baseNum += codec.MergedBundleSize
+			}
+			blocks = append(blocks, blk)
+			cursor = response.Cursor
+		}
+	}
 }
 
 func decodeAnyPB(in *anypb.Any) (*bstream.Block, error) {
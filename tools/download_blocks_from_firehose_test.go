@@ -0,0 +1,76 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingRanges(t *testing.T) {
+	tests := []struct {
+		name            string
+		startBase       uint64
+		stop            uint64
+		rangeSize       uint64
+		existing        map[uint64]bool
+		expectedRanges  []downloadRange
+		expectedSkipped int
+	}{
+		{
+			name:           "nothing existing",
+			startBase:      0,
+			stop:           500,
+			rangeSize:      1000,
+			expectedRanges: []downloadRange{{Start: 0, Stop: 500}},
+		},
+		{
+			name:           "split by range size",
+			startBase:      0,
+			stop:           500,
+			rangeSize:      200,
+			expectedRanges: []downloadRange{{Start: 0, Stop: 200}, {Start: 200, Stop: 400}, {Start: 400, Stop: 500}},
+		},
+		{
+			name:            "split around existing bundles",
+			startBase:       0,
+			stop:            800,
+			rangeSize:       1000,
+			existing:        map[uint64]bool{200: true, 300: true, 600: true},
+			expectedRanges:  []downloadRange{{Start: 0, Stop: 200}, {Start: 400, Stop: 600}, {Start: 700, Stop: 800}},
+			expectedSkipped: 3,
+		},
+		{
+			name:            "range size restarting after existing bundle",
+			startBase:       0,
+			stop:            600,
+			rangeSize:       200,
+			existing:        map[uint64]bool{100: true},
+			expectedRanges:  []downloadRange{{Start: 0, Stop: 100}, {Start: 200, Stop: 400}, {Start: 400, Stop: 600}},
+			expectedSkipped: 1,
+		},
+		{
+			name:            "everything existing",
+			startBase:       100,
+			stop:            300,
+			rangeSize:       1000,
+			existing:        map[uint64]bool{100: true, 200: true},
+			expectedSkipped: 2,
+		},
+		{
+			name:           "stop within a bundle",
+			startBase:      100,
+			stop:           250,
+			rangeSize:      1000,
+			existing:       map[uint64]bool{0: true, 300: true},
+			expectedRanges: []downloadRange{{Start: 100, Stop: 300}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ranges, skipped := missingRanges(test.startBase, test.stop, test.rangeSize, test.existing)
+			assert.Equal(t, test.expectedRanges, ranges)
+			assert.Equal(t, test.expectedSkipped, skipped)
+		})
+	}
+}
@@ -0,0 +1,440 @@
+package tools
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	"github.com/graphprotocol/firehose-cosmos/export"
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	"github.com/graphprotocol/firehose-cosmos/transform"
+	pbtransform "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/transform/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/stream"
+	bstransform "github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose"
+	pbfirehose "github.com/streamingfast/pbgo/sf/firehose/v2"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export {source-blocks-url} {destination-url|-} {start-block-num} {stop-block-num}",
+	Short: "Export a range of blocks as parquet, JSONL or CSV files of blocks, transactions, messages, events and attributes",
+	Long: `Export a range of blocks as parquet, JSONL or CSV files of blocks, transactions, messages, events and attributes.
+
+The range [start-block-num, stop-block-num) is split in partitions of --partition-size blocks, each one
+written as one file per table, ex: events/0000010000-0000019999.parquet. The tables reference each other
+by block height, transaction index and hash, and event ID (the stable identifier of the EventsOnly
+transform). Messages are exported with their type URL and a JSON representation, keyed by field number for
+the messages which definitions are not known to firecosmos, and holding their base64 encoded bytes
+({"@bytes":"..."}) for the messages which cannot be decoded.
+
+The JSONL and CSV formats (--format) write RFC3339 times, --columns selecting the columns of a single
+table. With "-" as destination, the rows of a single table are written to stdout as the blocks are read,
+without partitions, the logs being written to stderr with --log-to-stderr.
+
+The message type, event type and event origin filters are applied when the matching flags are set, the
+filters index files being used to skip blocks when --index-url is set. With --resume, the leading
+partitions which files all exist in the destination are skipped.`,
+	Args:    cobra.ExactArgs(4),
+	RunE:    exportE,
+	PreRunE: initFirstStreamable,
+	Example: `firecosmos tools export gs://my-bucket/merged-blocks gs://my-bucket/export 10000000 11000000 --tables blocks,transactions,messages --message-types '/cosmos.bank.v1beta1.*'
+firecosmos tools export gs://my-bucket/merged-blocks - 10000000 10000100 --log-to-stderr --format jsonl --tables events --event-types transfer | jq .
+firecosmos tools export gs://my-bucket/merged-blocks - 10000000 10000100 --log-to-stderr --format csv --tables transactions --columns block_height,hash,gas_used`,
+}
+
+func init() {
+	exportCmd.Flags().Uint64("partition-size", 10000, "Number of blocks of each partition")
+	exportCmd.Flags().StringSlice("tables", tablesNames(export.Tables), fmt.Sprintf("Tables to export, any of: %s", strings.Join(tablesNames(export.Tables), ", ")))
+	exportCmd.Flags().String("format", string(export.FormatParquet), fmt.Sprintf("Format of the exported files, any of: %v", export.Formats))
+	exportCmd.Flags().StringSlice("columns", nil, "If set, only export these columns, in this order, of the single exported table (jsonl and csv formats only)")
+	addFilterFlags(exportCmd.Flags())
+	exportCmd.Flags().Bool("resume", true, "Skip the leading partitions already exported")
+
+	Cmd.AddCommand(exportCmd)
+}
+
+// addFilterFlags adds the flags of the filters applied to the blocks, see filterTransforms
+func addFilterFlags(flags *pflag.FlagSet) {
+	flags.StringSlice("message-types", nil, "If set, only keep the messages of these types (and the events they emit), patterns are supported (ex: /cosmos.bank.v1beta1.*)")
+	flags.StringSlice("event-types", nil, "If set, only keep the events of these types, patterns are supported (ex: ibc_*)")
+	flags.StringSlice("event-origins", nil, "If set, only keep the events of these origins, any of: BeginBlock, DeliverTx, EndBlock")
+	flags.String("attributes-encoding", string(codec.AttributeEncodingPlain), "Encoding of the event attributes in the blocks, one of (plain, base64, auto), base64 encoded attributes are decoded")
+	flags.Uint64("attributes-until-height", 0, "Last block height which event attributes can be base64 encoded (0 for no limit)")
+	flags.String("index-url", "", "Store holding the index files of the filters, all the blocks are read when empty")
+	flags.IntSlice("lookup-indexes-sizes", []int{1000000, 100000, 10000, 1000}, "Index bundle sizes looked up in --index-url")
+}
+
+func tablesNames(tables []export.Table) []string {
+	out := make([]string, len(tables))
+	for i, table := range tables {
+		out[i] = string(table)
+	}
+	return out
+}
+
+// blockExporter writes the rows of the exported blocks
+type blockExporter interface {
+	processBlock(ctx context.Context, block *pbcosmos.Block) error
+	// finish is called once the blocks before stopBlockNum were processed
+	finish(ctx context.Context, stopBlockNum uint64) error
+}
+
+func exportE(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	toStdout := args[1] == "-"
+	startBlockNum, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse block number %q: %w", args[2], err)
+	}
+	stopBlockNum, err := strconv.ParseUint(args[3], 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse block number %q: %w", args[3], err)
+	}
+	partitions, err := export.Partitions(startBlockNum, stopBlockNum, mustGetUint64(cmd, "partition-size"))
+	if err != nil {
+		return err
+	}
+	tableNames, err := cmd.Flags().GetStringSlice("tables")
+	if err != nil {
+		return err
+	}
+	tables, err := export.ParseTables(tableNames)
+	if err != nil {
+		return err
+	}
+	format, err := export.ParseFormat(mustGetString(cmd, "format"))
+	if err != nil {
+		return err
+	}
+	columns, err := cmd.Flags().GetStringSlice("columns")
+	if err != nil {
+		return err
+	}
+	if len(columns) != 0 {
+		if format == export.FormatParquet {
+			return fmt.Errorf("--columns is only supported by the %s and %s formats", export.FormatJSONL, export.FormatCSV)
+		}
+		if len(tables) != 1 {
+			return fmt.Errorf("--columns requires a single table, got %v", tables)
+		}
+		if err := export.ValidateColumns(tables[0], columns); err != nil {
+			return err
+		}
+	}
+	if toStdout {
+		if format == export.FormatParquet {
+			return fmt.Errorf("the %s format cannot be written to stdout, use %s or %s", export.FormatParquet, export.FormatJSONL, export.FormatCSV)
+		}
+		if len(tables) != 1 {
+			return fmt.Errorf("a single table can be written to stdout, got %v", tables)
+		}
+		if !mustGetBool(cmd, "log-to-stderr") {
+			return fmt.Errorf("writing to stdout requires --log-to-stderr, the logs being mixed with the rows otherwise")
+		}
+	}
+	transforms, err := filterTransforms(cmd)
+	if err != nil {
+		return err
+	}
+
+	blocksStore, err := dstore.NewDBinStore(args[0])
+	if err != nil {
+		return fmt.Errorf("failed setting up block store from url %q: %w", args[0], err)
+	}
+	var exportStore dstore.Store
+	if !toStdout {
+		exportStore, err = dstore.NewStore(args[1], "", "", true)
+		if err != nil {
+			return fmt.Errorf("failed setting up export store from url %q: %w", args[1], err)
+		}
+	}
+	registry, err := filterRegistry(cmd)
+	if err != nil {
+		return err
+	}
+	cmd.SilenceUsage = true
+
+	if !toStdout && mustGetBool(cmd, "resume") {
+		skipped := 0
+		for _, partition := range partitions {
+			exported, err := partitionExported(ctx, exportStore, partition, tables, format.Extension())
+			if err != nil {
+				return err
+			}
+			if !exported {
+				break
+			}
+			skipped++
+		}
+		partitions = partitions[skipped:]
+		zlog.Info("resuming export", zap.Int("skipped_partition_count", skipped))
+		if len(partitions) == 0 {
+			zlog.Info("complete")
+			return nil
+		}
+	}
+
+	var exporter blockExporter
+	if toStdout {
+		exporter, err = newStreamExporter(os.Stdout, format, tables[0], columns)
+		if err != nil {
+			return err
+		}
+	} else {
+		exporter = &partitionExporter{
+			store:      exportStore,
+			format:     format,
+			tables:     tables,
+			columns:    columns,
+			partitions: partitions,
+			rows:       &export.Rows{},
+		}
+	}
+
+	handler := bstream.HandlerFunc(func(blk *bstream.Block, obj interface{}) error {
+		block, ok := obj.(bstream.ObjectWrapper).WrappedObject().(*pbcosmos.Block)
+		if !ok {
+			block = blk.ToProtocol().(*pbcosmos.Block)
+		}
+		return exporter.processBlock(ctx, block)
+	})
+
+	// The stop block of the request is inclusive
+	req := &pbfirehose.Request{
+		StartBlockNum:   int64(partitions[0].Start),
+		StopBlockNum:    stopBlockNum - 1,
+		FinalBlocksOnly: true,
+		Transforms:      transforms,
+	}
+
+	s, err := firehose.NewStreamFactory(blocksStore, nil, nil, registry).New(ctx, handler, req, true, zlog)
+	if err != nil {
+		return fmt.Errorf("getting firehose stream: %w", err)
+	}
+	if err := s.Run(ctx); err != nil {
+		if !errors.Is(err, stream.ErrStopBlockReached) {
+			return err
+		}
+	}
+
+	if err := exporter.finish(ctx, stopBlockNum); err != nil {
+		return err
+	}
+
+	zlog.Info("complete")
+	return nil
+}
+
+// filterTransforms returns the transforms applied to the blocks according to the flags of addFilterFlags
+func filterTransforms(cmd *cobra.Command) ([]*anypb.Any, error) {
+	encoding, err := codec.ParseAttributeEncoding(mustGetString(cmd, "attributes-encoding"))
+	if err != nil {
+		return nil, err
+	}
+	messageTypes, err := cmd.Flags().GetStringSlice("message-types")
+	if err != nil {
+		return nil, err
+	}
+	eventTypes, err := cmd.Flags().GetStringSlice("event-types")
+	if err != nil {
+		return nil, err
+	}
+	eventOrigins, err := cmd.Flags().GetStringSlice("event-origins")
+	if err != nil {
+		return nil, err
+	}
+	for _, origin := range eventOrigins {
+		switch transform.EventOrigin(origin) {
+		case transform.BeginBlock, transform.DeliverTx, transform.EndBlock:
+		default:
+			return nil, fmt.Errorf("invalid event origin %q, expecting one of %s, %s or %s", origin, transform.BeginBlock, transform.DeliverTx, transform.EndBlock)
+		}
+	}
+
+	var filters []proto.Message
+	if encoding != codec.AttributeEncodingPlain {
+		filters = append(filters, &pbfctransform.NormalizeAttributes{
+			Encoding:    string(encoding),
+			UntilHeight: mustGetUint64(cmd, "attributes-until-height"),
+		})
+	}
+	if len(messageTypes) != 0 {
+		filters = append(filters, &pbtransform.MessageTypeFilter{MessageTypes: messageTypes})
+	}
+	if len(eventTypes) != 0 {
+		filters = append(filters, &pbtransform.EventTypeFilter{EventTypes: eventTypes})
+	}
+	if len(eventOrigins) != 0 {
+		filters = append(filters, &pbtransform.EventOriginFilter{EventOrigins: eventOrigins})
+	}
+
+	var out []*anypb.Any
+	for _, filter := range filters {
+		message, err := anypb.New(filter)
+		if err != nil {
+			return nil, fmt.Errorf("packing %s: %w", proto.MessageName(filter), err)
+		}
+		out = append(out, message)
+	}
+	return out, nil
+}
+
+// filterRegistry returns the registry of the transforms of filterTransforms, the filters using the index files of
+// --index-url when set
+func filterRegistry(cmd *cobra.Command) (*bstransform.Registry, error) {
+	lookupIdxSizes, err := getLookupIndexesSizes(cmd)
+	if err != nil {
+		return nil, err
+	}
+	var indexStore dstore.Store
+	if indexStoreURL := mustGetString(cmd, "index-url"); indexStoreURL != "" {
+		indexStore, err = dstore.NewStore(indexStoreURL, "", "", false)
+		if err != nil {
+			return nil, fmt.Errorf("failed setting up an index store from url %q: %w", indexStoreURL, err)
+		}
+	}
+
+	registry := bstransform.NewRegistry()
+	registry.Register(transform.NormalizeAttributesFactory())
+	registry.Register(transform.EventOriginFilterFactory(indexStore, lookupIdxSizes))
+	registry.Register(transform.EventTypeFilterFactory(indexStore, lookupIdxSizes))
+	registry.Register(transform.MessageTypeFilterFactory(indexStore, lookupIdxSizes, true))
+	return registry, nil
+}
+
+// partitionExported returns whether the files of all the tables of the partition exist
+func partitionExported(ctx context.Context, store dstore.Store, partition export.Partition, tables []export.Table, extension string) (bool, error) {
+	for _, table := range tables {
+		filename := partition.Filename(table, extension)
+		exists, err := store.FileExists(ctx, filename)
+		if err != nil {
+			return false, fmt.Errorf("checking export file %q: %w", filename, err)
+		}
+		if !exists {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// partitionExporter accumulates the rows of the blocks of the current partition, writing its files once a block of
+// a later partition is seen
+type partitionExporter struct {
+	store      dstore.Store
+	format     export.Format
+	tables     []export.Table
+	columns    []string
+	partitions []export.Partition
+	rows       *export.Rows
+}
+
+func (e *partitionExporter) processBlock(ctx context.Context, block *pbcosmos.Block) error {
+	if block.Header == nil {
+		return fmt.Errorf("block has no header")
+	}
+	if err := e.flushUntil(ctx, block.Header.Height); err != nil {
+		return err
+	}
+	if len(e.partitions) == 0 || !e.partitions[0].Contains(block.Header.Height) {
+		return fmt.Errorf("block %d is out of the exported range", block.Header.Height)
+	}
+	return e.rows.Append(block)
+}
+
+// finish writes the remaining partitions, the ones without any block left (filtered out by the indexes) being
+// written empty
+func (e *partitionExporter) finish(ctx context.Context, stopBlockNum uint64) error {
+	return e.flushUntil(ctx, stopBlockNum)
+}
+
+// flushUntil writes the partitions ending at or before blockNum
+func (e *partitionExporter) flushUntil(ctx context.Context, blockNum uint64) error {
+	for len(e.partitions) > 0 && e.partitions[0].Stop <= blockNum {
+		if err := e.writePartition(ctx, e.partitions[0]); err != nil {
+			return err
+		}
+		e.partitions = e.partitions[1:]
+		e.rows.Reset()
+	}
+	return nil
+}
+
+func (e *partitionExporter) writePartition(ctx context.Context, partition export.Partition) error {
+	fields := []zap.Field{zap.Stringer("partition", partition)}
+	for _, table := range e.tables {
+		buf := new(bytes.Buffer)
+		if err := export.Write(buf, e.format, table, e.rows, e.columns); err != nil {
+			return fmt.Errorf("partition %s: %w", partition, err)
+		}
+
+		filename := partition.Filename(table, e.format.Extension())
+		if err := e.store.WriteObject(ctx, filename, buf); err != nil {
+			return fmt.Errorf("writing export file %q: %w", filename, err)
+		}
+		fields = append(fields, zap.Int(string(table), e.rows.Len(table)))
+	}
+
+	zlog.Info("exported partition", fields...)
+	return nil
+}
+
+// streamExporter writes the rows of a single table as the blocks are processed
+type streamExporter struct {
+	writer  *bufio.Writer
+	format  export.Format
+	table   export.Table
+	columns []string
+	rows    *export.Rows
+}
+
+func newStreamExporter(w io.Writer, format export.Format, table export.Table, columns []string) (*streamExporter, error) {
+	e := &streamExporter{
+		writer:  bufio.NewWriter(w),
+		format:  format,
+		table:   table,
+		columns: columns,
+		rows:    &export.Rows{},
+	}
+	if format == export.FormatCSV {
+		if err := export.WriteCSV(e.writer, table, e.rows, columns, true); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+func (e *streamExporter) processBlock(ctx context.Context, block *pbcosmos.Block) error {
+	e.rows.Reset()
+	if err := e.rows.Append(block); err != nil {
+		return err
+	}
+
+	switch e.format {
+	case export.FormatJSONL:
+		return export.WriteJSONL(e.writer, e.table, e.rows, e.columns)
+	case export.FormatCSV:
+		return export.WriteCSV(e.writer, e.table, e.rows, e.columns, false)
+	}
+	return fmt.Errorf("the %s format cannot be streamed", e.format)
+}
+
+func (e *streamExporter) finish(ctx context.Context, stopBlockNum uint64) error {
+	return e.writer.Flush()
+}
@@ -0,0 +1,244 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphprotocol/firehose-cosmos/transform"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/stream"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose"
+	pbfirehose "github.com/streamingfast/pbgo/sf/firehose/v2"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+var generateIndexesCmd = &cobra.Command{
+	Use:   "generate-indexes {index-url} {source-blocks-url} {start-block-num} [stop-block-num]",
+	Short: "Generate index files for all (or a subset of) the registered indexers in a single pass over the blocks",
+	Long: `Generate index files for all (or a subset of) the registered indexers in a single pass over the blocks.
+
+Each indexer resumes from its own first unindexed block, the blocks are streamed from the lowest one.
+When --shard-size is set, the range is split in shards of that many blocks which are indexed concurrently,
+a stop block is then required. The stop block should be aligned on --indexes-size, otherwise the last
+partial index bundle is not written.`,
+	Args: cobra.RangeArgs(3, 4),
+	RunE: generateIndexesE,
+}
+
+func init() {
+	generateIndexesCmd.Flags().Uint64("first-streamable-block", 0, "first streamable block of this chain")
+	generateIndexesCmd.Flags().Uint64("indexes-size", 10000, "size of index bundles that will be created")
+	generateIndexesCmd.Flags().IntSlice("lookup-indexes-sizes", []int{1000000, 100000, 10000, 1000}, "index bundle sizes that we will look for on start to find first unindexed block (should include indexes-size)")
+	generateIndexesCmd.Flags().StringSlice("indexers", transform.RegisteredIndexers(), fmt.Sprintf("indexers to run, any of: %s", strings.Join(transform.RegisteredIndexers(), ", ")))
+	generateIndexesCmd.Flags().Uint64("shard-size", 0, "if non-zero, split the range in shards of this many blocks (must be a multiple of indexes-size)")
+	generateIndexesCmd.Flags().Int("parallel", 1, "number of shards processed concurrently")
+
+	Cmd.AddCommand(generateIndexesCmd)
+}
+
+type indexShard struct {
+	start uint64
+	stop  uint64 // inclusive, 0 means unbounded
+}
+
+func generateIndexesE(cmd *cobra.Command, args []string) error {
+	var err error
+	bstream.GetProtocolFirstStreamableBlock, err = cmd.Flags().GetUint64("first-streamable-block")
+	if err != nil {
+		return err
+	}
+	idxSize, err := cmd.Flags().GetUint64("indexes-size")
+	if err != nil {
+		return err
+	}
+	if idxSize == 0 {
+		return fmt.Errorf("indexes-size must be greater than 0")
+	}
+	lookupIdxSizes, err := getLookupIndexesSizes(cmd)
+	if err != nil {
+		return err
+	}
+	shortNames, err := cmd.Flags().GetStringSlice("indexers")
+	if err != nil {
+		return err
+	}
+	if len(shortNames) == 0 {
+		return fmt.Errorf("at least one indexer is required, valid values are %v", transform.RegisteredIndexers())
+	}
+	shardSize, err := cmd.Flags().GetUint64("shard-size")
+	if err != nil {
+		return err
+	}
+	parallel, err := cmd.Flags().GetInt("parallel")
+	if err != nil {
+		return err
+	}
+	if parallel < 1 {
+		return fmt.Errorf("invalid parallel value %d, must be at least 1", parallel)
+	}
+
+	indexStoreURL := args[0]
+	blocksStoreURL := args[1]
+	startBlockNum, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse block number %q: %w", args[2], err)
+	}
+	var stopBlockNum uint64
+	if len(args) == 4 {
+		stopBlockNum, err = strconv.ParseUint(args[3], 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to parse block number %q: %w", args[3], err)
+		}
+	}
+
+	shards, err := planIndexShards(startBlockNum, stopBlockNum, idxSize, shardSize)
+	if err != nil {
+		return err
+	}
+
+	mergedBlocksStore, err := dstore.NewDBinStore(blocksStoreURL)
+	if err != nil {
+		return fmt.Errorf("failed setting up block store from url %q: %w", blocksStoreURL, err)
+	}
+
+	indexStore, err := dstore.NewStore(indexStoreURL, "", "", false)
+	if err != nil {
+		return fmt.Errorf("failed setting up an index store from url %q: %w", indexStoreURL, err)
+	}
+
+	registered := make(map[string]bool)
+	for _, shortName := range transform.RegisteredIndexers() {
+		registered[shortName] = true
+	}
+	for _, shortName := range shortNames {
+		if !registered[shortName] {
+			return fmt.Errorf("unknown indexer %q, valid values are %v", shortName, transform.RegisteredIndexers())
+		}
+	}
+	cmd.SilenceUsage = true
+
+	streamFactory := firehose.NewStreamFactory(
+		mergedBlocksStore,
+		nil,
+		nil,
+		nil,
+	)
+
+	eg, ctx := errgroup.WithContext(context.Background())
+	eg.SetLimit(parallel)
+	for _, shard := range shards {
+		shard := shard
+		eg.Go(func() error {
+			return runIndexShard(ctx, streamFactory, indexStore, shard, shortNames, idxSize, lookupIdxSizes)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	zlog.Info("complete")
+	return nil
+}
+
+func planIndexShards(startBlockNum, stopBlockNum, idxSize, shardSize uint64) ([]indexShard, error) {
+	if shardSize == 0 {
+		return []indexShard{{start: startBlockNum, stop: stopBlockNum}}, nil
+	}
+	if shardSize%idxSize != 0 {
+		return nil, fmt.Errorf("shard-size %d must be a multiple of indexes-size %d", shardSize, idxSize)
+	}
+	if stopBlockNum == 0 {
+		return nil, fmt.Errorf("a stop block is required when sharding")
+	}
+	if stopBlockNum <= startBlockNum {
+		return nil, fmt.Errorf("stop block %d must be greater than start block %d", stopBlockNum, startBlockNum)
+	}
+
+	var shards []indexShard
+	for start := startBlockNum - startBlockNum%shardSize; start < stopBlockNum; start += shardSize {
+		shard := indexShard{start: start, stop: start + shardSize}
+		if shard.start < startBlockNum {
+			shard.start = startBlockNum
+		}
+		if shard.stop > stopBlockNum {
+			shard.stop = stopBlockNum
+		}
+		shards = append(shards, shard)
+	}
+	return shards, nil
+}
+
+func runIndexShard(
+	ctx context.Context,
+	streamFactory *firehose.StreamFactory,
+	indexStore dstore.Store,
+	shard indexShard,
+	shortNames []string,
+	idxSize uint64,
+	lookupIdxSizes []uint64,
+) error {
+	logger := zlog.With(zap.Uint64("shard_start", shard.start), zap.Uint64("shard_stop", shard.stop))
+
+	indexers, err := transform.NewIndexerSet(ctx, indexStore, shortNames, idxSize, lookupIdxSizes, shard.start, shard.stop)
+	if err != nil {
+		return err
+	}
+	if indexers.Len() == 0 {
+		logger.Info("shard already indexed")
+		return nil
+	}
+
+	handler := bstream.HandlerFunc(func(blk *bstream.Block, obj interface{}) error {
+		indexers.ProcessBlock(blk.ToProtocol().(*pbcosmos.Block))
+		return nil
+	})
+
+	// The stop block is inclusive, seeing it flushes the last index bundle of the shard
+	req := &pbfirehose.Request{
+		StartBlockNum:   int64(indexers.StartBlock()),
+		StopBlockNum:    shard.stop,
+		FinalBlocksOnly: true,
+	}
+
+	s, err := streamFactory.New(
+		ctx,
+		handler,
+		req,
+		true,
+		logger,
+	)
+	if err != nil {
+		return fmt.Errorf("getting firehose stream: %w", err)
+	}
+
+	if err := s.Run(ctx); err != nil {
+		if !errors.Is(err, stream.ErrStopBlockReached) {
+			return err
+		}
+	}
+	logger.Info("shard complete")
+	return nil
+}
+
+func getLookupIndexesSizes(cmd *cobra.Command) ([]uint64, error) {
+	lais, err := cmd.Flags().GetIntSlice("lookup-indexes-sizes")
+	if err != nil {
+		return nil, err
+	}
+	var lookupIdxSizes []uint64
+	for _, size := range lais {
+		if size < 0 {
+			return nil, fmt.Errorf("invalid negative size for bundle-sizes: %d", size)
+		}
+		lookupIdxSizes = append(lookupIdxSizes, uint64(size))
+	}
+	return lookupIdxSizes, nil
+}
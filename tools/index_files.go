@@ -0,0 +1,75 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/graphprotocol/firehose-cosmos/transform"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/dstore"
+	pbbstream "github.com/streamingfast/pbgo/sf/bstream/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// readIndexFile returns the bitmap of every key found in the index file
+func readIndexFile(ctx context.Context, store dstore.Store, f transform.IndexFile) (map[string]*roaring64.Bitmap, error) {
+	reader, err := store.OpenObject(ctx, f.Filename())
+	if err != nil {
+		return nil, fmt.Errorf("opening index file %q: %w", f.Filename(), err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading index file %q: %w", f.Filename(), err)
+	}
+
+	pbIndex := &pbbstream.GenericBlockIndex{}
+	if err := proto.Unmarshal(data, pbIndex); err != nil {
+		return nil, fmt.Errorf("unmarshalling index file %q: %w", f.Filename(), err)
+	}
+
+	out := make(map[string]*roaring64.Bitmap, len(pbIndex.Kv))
+	for _, kv := range pbIndex.Kv {
+		bitmap := roaring64.NewBitmap()
+		if err := bitmap.UnmarshalBinary(kv.Bitmap); err != nil {
+			return nil, fmt.Errorf("unmarshalling bitmap of key %q in index file %q: %w", string(kv.Key), f.Filename(), err)
+		}
+		out[string(kv.Key)] = bitmap
+	}
+	return out, nil
+}
+
+// writeIndexFile writes the bitmaps to the index file, in the format read by `transform.ReadNewBlockIndex`
+func writeIndexFile(ctx context.Context, store dstore.Store, f transform.IndexFile, bitmaps map[string]*roaring64.Bitmap) error {
+	keys := make([]string, 0, len(bitmaps))
+	for key := range bitmaps {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pbIndex := &pbbstream.GenericBlockIndex{}
+	for _, key := range keys {
+		bitmapBytes, err := bitmaps[key].ToBytes()
+		if err != nil {
+			return fmt.Errorf("marshalling bitmap of key %q: %w", key, err)
+		}
+		pbIndex.Kv = append(pbIndex.Kv, &pbbstream.KeyToBitmap{
+			Key:    []byte(key),
+			Bitmap: bitmapBytes,
+		})
+	}
+
+	data, err := proto.Marshal(pbIndex)
+	if err != nil {
+		return fmt.Errorf("marshalling index file %q: %w", f.Filename(), err)
+	}
+	if err := store.WriteObject(ctx, f.Filename(), bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("writing index file %q: %w", f.Filename(), err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/transform"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/dstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteIndexFile_Shard(t *testing.T) {
+	ctx := context.Background()
+	store, err := dstore.NewStore(t.TempDir(), "", "", false)
+	require.NoError(t, err)
+
+	f := transform.IndexFile{BaseBlockNum: 100, Size: 100, ShortName: transform.TxHashIndexShortName, Shard: "9D"}
+	require.NoError(t, writeIndexFile(ctx, store, f, map[string]*roaring64.Bitmap{"9D3C4AE0": roaring64.BitmapOf(142, 157)}))
+
+	files, unknown, err := transform.ListIndexFiles(ctx, store)
+	require.NoError(t, err)
+	assert.Empty(t, unknown)
+	assert.Equal(t, []transform.IndexFile{f}, files)
+
+	// The files of a shard are at the top level of its store
+	shardStore, err := transform.IndexShardStore(store, transform.TxHashIndexShortName, "9D")
+	require.NoError(t, err)
+	shardFiles, err := transform.ListIndexFilesFrom(ctx, shardStore, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []transform.IndexFile{{BaseBlockNum: 100, Size: 100, ShortName: transform.TxHashIndexShortName}}, shardFiles)
+
+	bitmaps, err := readIndexFile(ctx, store, f)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{142, 157}, bitmaps["9D3C4AE0"].ToArray())
+}
@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+)
+
+func bundleBaseNum(blockNum uint64) uint64 {
+	return blockNum - (blockNum % codec.MergedBundleSize)
+}
+
+func bundleFilename(baseNum uint64) string {
+	return fmt.Sprintf("%010d", baseNum)
+}
+
+// bundleFirstBlockNum returns the first block expected in the bundle starting at baseNum,
+// taking the first streamable block of the chain into account.
+func bundleFirstBlockNum(baseNum uint64) uint64 {
+	if first := bstream.GetProtocolFirstStreamableBlock; first > baseNum && first < baseNum+codec.MergedBundleSize {
+		return first
+	}
+	return baseNum
+}
+
+// isCompleteBundle checks that blocks, sorted by number, hold every block of the bundle starting at baseNum
+func isCompleteBundle(baseNum uint64, blocks []*bstream.Block) bool {
+	first := bundleFirstBlockNum(baseNum)
+	if uint64(len(blocks)) != baseNum+codec.MergedBundleSize-first {
+		return false
+	}
+	for i, blk := range blocks {
+		if blk.Number != first+uint64(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// writeMergedBundle writes blocks as a single merged blocks file using the codec block writer
+func writeMergedBundle(ctx context.Context, store dstore.Store, baseNum uint64, blocks []*bstream.Block) error {
+	if len(blocks) == 0 {
+		return fmt.Errorf("no blocks to write to bundle %d", baseNum)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		var err error
+		defer func() {
+			pw.CloseWithError(err)
+		}()
+
+		blockWriter, err := codec.NewBlockWriter(pw)
+		if err != nil {
+			return
+		}
+		for _, blk := range blocks {
+			if err = blockWriter.Write(blk); err != nil {
+				return
+			}
+		}
+	}()
+
+	filename := bundleFilename(baseNum)
+	if err := store.WriteObject(ctx, filename, pr); err != nil {
+		return fmt.Errorf("writing merged blocks file %q: %w", filename, err)
+	}
+
+	zlog.Info("wrote merged blocks file", zap.String("filename", filename), zap.Int("block_count", len(blocks)))
+	return nil
+}
+
+// listMergedBundles returns the base block number of every merged blocks file found in the store
+func listMergedBundles(ctx context.Context, store dstore.Store) (map[uint64]bool, error) {
+	out := make(map[uint64]bool)
+	err := store.Walk(ctx, "", func(filename string) error {
+		baseNum, err := strconv.ParseUint(filename, 10, 64)
+		if err != nil {
+			zlog.Debug("skipping unknown file in merged blocks store", zap.String("filename", filename))
+			return nil
+		}
+		out[baseNum] = true
+		return nil
+	})
+	return out, err
+}
+
+// readMergedBundle returns every block of the merged blocks file starting at baseNum
+func readMergedBundle(ctx context.Context, store dstore.Store, baseNum uint64) ([]*bstream.Block, error) {
+	filename := bundleFilename(baseNum)
+	reader, err := store.OpenObject(ctx, filename)
+	if err != nil {
+		return nil, fmt.Errorf("opening merged blocks file %q: %w", filename, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := codec.NewBlockReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading merged blocks file %q: %w", filename, err)
+	}
+
+	var blocks []*bstream.Block
+	for {
+		blk, err := blockReader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return blocks, nil
+			}
+			return nil, fmt.Errorf("reading merged blocks file %q: %w", filename, err)
+		}
+		blocks = append(blocks, blk)
+	}
+}
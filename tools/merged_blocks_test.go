@@ -0,0 +1,67 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBundleBlocks(start, stop uint64) (out []*bstream.Block) {
+	for num := start; num < stop; num++ {
+		out = append(out, &bstream.Block{Number: num})
+	}
+	return out
+}
+
+func TestIsCompleteBundle(t *testing.T) {
+	defer func(first uint64) { bstream.GetProtocolFirstStreamableBlock = first }(bstream.GetProtocolFirstStreamableBlock)
+	bstream.GetProtocolFirstStreamableBlock = 150
+
+	tests := []struct {
+		name     string
+		baseNum  uint64
+		blocks   []*bstream.Block
+		expected bool
+	}{
+		{"complete", 200, testBundleBlocks(200, 300), true},
+		{"missing last block", 200, testBundleBlocks(200, 299), false},
+		{"missing first block", 200, testBundleBlocks(201, 300), false},
+		{"missing middle block", 200, append(testBundleBlocks(200, 250), testBundleBlocks(251, 300)...), false},
+		{"block of next bundle", 200, testBundleBlocks(200, 301), false},
+		{"no blocks", 200, nil, false},
+		{"first bundle from first streamable block", 100, testBundleBlocks(150, 200), true},
+		{"first bundle from its base", 100, testBundleBlocks(100, 200), false},
+		{"first bundle missing first streamable block", 100, testBundleBlocks(151, 200), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isCompleteBundle(test.baseNum, test.blocks))
+		})
+	}
+}
+
+func TestListMergedBundles(t *testing.T) {
+	ctx := context.Background()
+	store, err := dstore.NewDBinStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, filename := range []string{"0000000000", "0000000100", "0000000300", "notes"} {
+		require.NoError(t, store.WriteObject(ctx, filename, strings.NewReader("blocks")))
+	}
+
+	bundles, err := listMergedBundles(ctx, store)
+	require.NoError(t, err)
+	assert.Equal(t, map[uint64]bool{0: true, 100: true, 300: true}, bundles)
+
+	emptyStore, err := dstore.NewDBinStore(t.TempDir())
+	require.NoError(t, err)
+	bundles, err = listMergedBundles(ctx, emptyStore)
+	require.NoError(t, err)
+	assert.Empty(t, bundles)
+}
@@ -0,0 +1,197 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync/atomic"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+var recompressBlocksCmd = &cobra.Command{
+	Use:   "recompress-blocks {source-blocks-url} {destination-blocks-url}",
+	Short: "Rewrite merged blocks files at another version, compressing or decompressing their blocks",
+	Long: `Rewrite merged blocks files at another version, compressing or decompressing their blocks.
+
+Version 1 files hold raw blocks, version 2 files hold zstd compressed blocks. Both versions are read
+transparently, so a store can be migrated while firehose is serving it. The destination can be the
+source store itself, each file being read completely before being overwritten. With --resume, the
+destination files already at the target version are skipped.
+
+Merged blocks stores also compress whole files with zstd by default: version 2 saves space on stores
+configured without compression, compare the size of a few rewritten files before migrating a whole
+store. The byte counts logged are the sizes of the files before the compression of the store.`,
+	Args:    cobra.ExactArgs(2),
+	RunE:    recompressBlocksE,
+	Example: "firecosmos tools recompress-blocks gs://my-bucket/merged-blocks gs://my-bucket/merged-blocks-v2 --version 2",
+}
+
+func init() {
+	recompressBlocksCmd.Flags().Int("version", codec.BlockVersionZstd, "Version of the merged blocks files written, 1 for raw blocks or 2 for zstd compressed blocks")
+	recompressBlocksCmd.Flags().Uint64("start-block", 0, "First block of the range to rewrite")
+	recompressBlocksCmd.Flags().Uint64("stop-block", 0, "Block at which to stop, exclusive (0 for no limit)")
+	recompressBlocksCmd.Flags().Int("parallel", 4, "Number of merged blocks files rewritten concurrently")
+	recompressBlocksCmd.Flags().Bool("resume", true, "Skip the destination files already at the target version")
+
+	Cmd.AddCommand(recompressBlocksCmd)
+}
+
+func recompressBlocksE(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	version := mustGetInt(cmd, "version")
+	if version != codec.BlockVersionRaw && version != codec.BlockVersionZstd {
+		return fmt.Errorf("invalid version %d, expecting %d or %d", version, codec.BlockVersionRaw, codec.BlockVersionZstd)
+	}
+	startBlock := mustGetUint64(cmd, "start-block")
+	stopBlock := mustGetUint64(cmd, "stop-block")
+	resume := mustGetBool(cmd, "resume")
+	parallel := mustGetInt(cmd, "parallel")
+	if parallel < 1 {
+		return fmt.Errorf("invalid parallel value %d, must be at least 1", parallel)
+	}
+
+	sourceStore, err := dstore.NewDBinStore(args[0])
+	if err != nil {
+		return fmt.Errorf("failed setting up source block store from url %q: %w", args[0], err)
+	}
+	destinationStore, err := dstore.NewDBinStore(args[1])
+	if err != nil {
+		return fmt.Errorf("failed setting up destination block store from url %q: %w", args[1], err)
+	}
+	cmd.SilenceUsage = true
+
+	bundles, err := listMergedBundles(ctx, sourceStore)
+	if err != nil {
+		return fmt.Errorf("listing source merged blocks files: %w", err)
+	}
+
+	var baseNums []uint64
+	for baseNum := range bundles {
+		if baseNum+codec.MergedBundleSize <= startBlock || (stopBlock != 0 && baseNum >= stopBlock) {
+			continue
+		}
+		baseNums = append(baseNums, baseNum)
+	}
+	sort.Slice(baseNums, func(i, j int) bool { return baseNums[i] < baseNums[j] })
+	zlog.Info("rewriting merged blocks files", zap.Int("file_count", len(baseNums)), zap.Int("version", version))
+
+	var readBytes, writtenBytes, skipped int64
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(parallel)
+	for _, baseNum := range baseNums {
+		baseNum := baseNum
+		eg.Go(func() error {
+			if resume {
+				current, err := mergedBundleVersion(egCtx, destinationStore, baseNum)
+				if err != nil {
+					return err
+				}
+				if current == int32(version) {
+					atomic.AddInt64(&skipped, 1)
+					return nil
+				}
+			}
+
+			read, written, err := recompressMergedBundle(egCtx, sourceStore, destinationStore, baseNum, version)
+			if err != nil {
+				return err
+			}
+			atomic.AddInt64(&readBytes, read)
+			atomic.AddInt64(&writtenBytes, written)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	zlog.Info("complete",
+		zap.Int64("read_bytes", readBytes),
+		zap.Int64("written_bytes", writtenBytes),
+		zap.Int64("skipped_file_count", skipped),
+	)
+	return nil
+}
+
+// mergedBundleVersion returns the version of the merged blocks file starting at baseNum, 0 when it does not exist
+func mergedBundleVersion(ctx context.Context, store dstore.Store, baseNum uint64) (int32, error) {
+	filename := bundleFilename(baseNum)
+	reader, err := store.OpenObject(ctx, filename)
+	if err != nil {
+		if errors.Is(err, dstore.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("opening merged blocks file %q: %w", filename, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := codec.NewBlockReader(reader)
+	if err != nil {
+		return 0, fmt.Errorf("reading merged blocks file %q: %w", filename, err)
+	}
+	return blockReader.Version(), nil
+}
+
+// recompressMergedBundle rewrites the merged blocks file starting at baseNum at version, returning the sizes of the
+// source and destination files
+func recompressMergedBundle(ctx context.Context, source, destination dstore.Store, baseNum uint64, version int) (read int64, written int64, err error) {
+	filename := bundleFilename(baseNum)
+	reader, err := source.OpenObject(ctx, filename)
+	if err != nil {
+		return 0, 0, fmt.Errorf("opening merged blocks file %q: %w", filename, err)
+	}
+	defer reader.Close()
+
+	counter := &countingReader{reader: reader}
+	blockReader, err := codec.NewBlockReader(counter)
+	if err != nil {
+		return 0, 0, fmt.Errorf("reading merged blocks file %q: %w", filename, err)
+	}
+
+	buf := new(bytes.Buffer)
+	blockWriter, err := codec.NewBlockWriter(buf, codec.WithBlockVersion(version))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for {
+		blk, err := blockReader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return 0, 0, fmt.Errorf("reading merged blocks file %q: %w", filename, err)
+		}
+		if err := blockWriter.Write(blk); err != nil {
+			return 0, 0, fmt.Errorf("writing block %d: %w", blk.Number, err)
+		}
+	}
+
+	written = int64(buf.Len())
+	if err := destination.WriteObject(ctx, filename, buf); err != nil {
+		return 0, 0, fmt.Errorf("writing merged blocks file %q: %w", filename, err)
+	}
+
+	zlog.Debug("rewrote merged blocks file", zap.String("filename", filename), zap.Int64("read_bytes", counter.count), zap.Int64("written_bytes", written))
+	return counter.count, written, nil
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
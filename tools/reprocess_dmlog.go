@@ -0,0 +1,251 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	"github.com/graphprotocol/firehose-cosmos/noderunner"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+var reprocessDmlogCmd = &cobra.Command{
+	Use:   "reprocess-dmlog {merged-blocks-store-url} [input-file...]",
+	Short: "Assemble blocks from DMLOG instrumentation output and write merged blocks files directly to a store",
+	Long: `Assemble blocks from DMLOG instrumentation output and write merged blocks files directly to a store.
+
+Input files are read in parallel, each one must contain a contiguous, increasing range of blocks. Bundles
+crossing input files boundaries are stitched together once all inputs have been read. When no input file
+is given (or when '-' is used), DMLOG lines are read from stdin.`,
+	Args:    cobra.MinimumNArgs(1),
+	PreRunE: initFirstStreamable,
+	RunE:    reprocessDmlogE,
+	Example: "firecosmos tools reprocess-dmlog ./merged-blocks node-1.log node-2.log\n  cat node.log | firecosmos tools reprocess-dmlog ./merged-blocks",
+}
+
+func init() {
+	reprocessDmlogCmd.Flags().Int("parallel", 1, "Number of input files processed concurrently")
+	reprocessDmlogCmd.Flags().Bool("resume", true, "Skip bundles already present in the destination store")
+	reprocessDmlogCmd.Flags().String("attributes-encoding", string(codec.AttributeEncodingPlain), "Encoding of the event attributes of the input, one of (plain, base64, auto), base64 encoded attributes are decoded before blocks are written")
+	reprocessDmlogCmd.Flags().Uint64("attributes-until-height", 0, "Last block height which event attributes can be base64 encoded (0 for no limit)")
+
+	Cmd.AddCommand(reprocessDmlogCmd)
+}
+
+func reprocessDmlogE(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	storeURL := args[0]
+	inputs := args[1:]
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+
+	stdinCount := 0
+	for _, input := range inputs {
+		if input == "-" {
+			stdinCount++
+		}
+	}
+	if stdinCount > 1 {
+		return fmt.Errorf("stdin ('-') can only be used once as input")
+	}
+
+	parallel, err := cmd.Flags().GetInt("parallel")
+	if err != nil {
+		return err
+	}
+	if parallel < 1 {
+		return fmt.Errorf("invalid parallel value %d, must be at least 1", parallel)
+	}
+
+	if err := codec.SetBlockWriterVersion(mustGetInt(cmd, "common-blocks-version")); err != nil {
+		return err
+	}
+
+	attributeEncoding, err := codec.ParseAttributeEncoding(mustGetString(cmd, "attributes-encoding"))
+	if err != nil {
+		return err
+	}
+	attributeNormalizer := codec.NewAttributeNormalizer(attributeEncoding, mustGetUint64(cmd, "attributes-until-height"))
+
+	store, err := dstore.NewDBinStore(storeURL)
+	if err != nil {
+		return fmt.Errorf("failed setting up block store from url %q: %w", storeURL, err)
+	}
+	cmd.SilenceUsage = true
+
+	existingBundles := map[uint64]bool{}
+	if mustGetBool(cmd, "resume") {
+		existingBundles, err = listMergedBundles(ctx, store)
+		if err != nil {
+			return fmt.Errorf("listing existing merged blocks files: %w", err)
+		}
+		zlog.Info("resuming, existing bundles will be skipped", zap.Int("existing_bundle_count", len(existingBundles)))
+	}
+
+	var lock sync.Mutex
+	partialBundles := make(map[uint64][]*bstream.Block)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(parallel)
+	for _, input := range inputs {
+		input := input
+		eg.Go(func() error {
+			partials, err := reprocessDmlogInput(egCtx, store, input, existingBundles, attributeNormalizer)
+			if err != nil {
+				return err
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			for baseNum, blocks := range partials {
+				partialBundles[baseNum] = append(partialBundles[baseNum], blocks...)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	if err := writePartialBundles(ctx, store, partialBundles); err != nil {
+		return err
+	}
+
+	zlog.Info("complete")
+	return nil
+}
+
+// reprocessDmlogInput writes every complete bundle found in the input and returns the incomplete ones,
+// which are expected to be completed by blocks from other inputs.
+func reprocessDmlogInput(ctx context.Context, store dstore.Store, input string, skipBundles map[uint64]bool, attributeNormalizer *codec.AttributeNormalizer) (partials map[uint64][]*bstream.Block, err error) {
+	var reader io.Reader = os.Stdin
+	if input != "-" {
+		f, err := os.Open(input)
+		if err != nil {
+			return nil, fmt.Errorf("opening input %q: %w", input, err)
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	lines := make(chan string, 1000)
+	lineReaderDone := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		lineReaderDone <- noderunner.StartLineReader(reader, func(line string) {
+			select {
+			case lines <- line:
+			case <-ctx.Done():
+			}
+		}, zlog)
+	}()
+
+	consoleReader, err := codec.NewConsoleReader(lines, zlog, codec.WithAttributeNormalizer(attributeNormalizer))
+	if err != nil {
+		return nil, err
+	}
+
+	partials = make(map[uint64][]*bstream.Block)
+
+	var baseNum uint64
+	var blocks []*bstream.Block
+	flush := func() error {
+		if len(blocks) == 0 {
+			return nil
+		}
+		defer func() { blocks = nil }()
+
+		if isCompleteBundle(baseNum, blocks) {
+			return writeMergedBundle(ctx, store, baseNum, blocks)
+		}
+		partials[baseNum] = blocks
+		return nil
+	}
+
+	for {
+		blk, err := consoleReader.ReadBlock()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("reading blocks from input %q: %w", input, err)
+		}
+
+		blkBaseNum := bundleBaseNum(blk.Number)
+		if skipBundles[blkBaseNum] {
+			continue
+		}
+
+		if len(blocks) > 0 && blkBaseNum != baseNum {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+
+		baseNum = blkBaseNum
+		blocks = append(blocks, blk)
+
+		if blk.Number == baseNum+codec.MergedBundleSize-1 {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	if err := <-lineReaderDone; err != nil {
+		return nil, fmt.Errorf("reading lines from input %q: %w", input, err)
+	}
+
+	zlog.Info("input processed", zap.String("input", input), zap.Int("partial_bundle_count", len(partials)))
+	return partials, nil
+}
+
+// writePartialBundles stitches together bundles split across inputs and writes the ones that are complete
+func writePartialBundles(ctx context.Context, store dstore.Store, partialBundles map[uint64][]*bstream.Block) error {
+	var baseNums []uint64
+	for baseNum := range partialBundles {
+		baseNums = append(baseNums, baseNum)
+	}
+	sort.Slice(baseNums, func(i, j int) bool { return baseNums[i] < baseNums[j] })
+
+	for _, baseNum := range baseNums {
+		blocks := partialBundles[baseNum]
+		sort.SliceStable(blocks, func(i, j int) bool { return blocks[i].Number < blocks[j].Number })
+
+		var deduped []*bstream.Block
+		for _, blk := range blocks {
+			if len(deduped) > 0 && deduped[len(deduped)-1].Number == blk.Number {
+				continue
+			}
+			deduped = append(deduped, blk)
+		}
+
+		if !isCompleteBundle(baseNum, deduped) {
+			zlog.Warn("skipping incomplete bundle", zap.Uint64("base_block_num", baseNum), zap.Int("block_count", len(deduped)))
+			continue
+		}
+
+		if err := writeMergedBundle(ctx, store, baseNum, deduped); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package tools
+
+import (
+	"testing"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/streamingfast/bstream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBelowCursorLIB(t *testing.T) {
+	cursor := func(blockNum, libNum uint64) string {
+		block := bstream.NewBlockRef("b", blockNum)
+		return (&bstream.Cursor{Step: bstream.StepNew, Block: block, HeadBlock: block, LIB: bstream.NewBlockRef("l", libNum)}).ToOpaque()
+	}
+	block := func(height uint64) *pbcosmos.Block {
+		return &pbcosmos.Block{Header: &pbcosmos.Header{Height: height}}
+	}
+
+	// Historical blocks are sent along with a LIB at their height
+	assert.True(t, belowCursorLIB(block(100), cursor(100, 100)))
+	assert.True(t, belowCursorLIB(block(100), cursor(100, 120)))
+	assert.False(t, belowCursorLIB(block(100), cursor(100, 99)))
+	assert.False(t, belowCursorLIB(block(100), "invalid"))
+}
@@ -0,0 +1,195 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	"github.com/graphprotocol/firehose-cosmos/transform"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+var txCmd = &cobra.Command{
+	Use:   "tx {blocks-url} {index-url} {tx-hash}",
+	Short: "Print a transaction and the header of its block, found by hash using the tx hash index",
+	Long: `Print a transaction and the header of its block, found by hash using the tx hash index.
+
+The tx hash index files (short name "txhash", written by the indexer app or generate-indexes) map the
+prefix of the transaction hashes to the blocks including them. They are sharded by the first byte of the
+hashes, in the txhash/{shard} directories of the index store (ex: txhash/9D/0000000000.10000.txhash.idx),
+the files of the shard of the hash only being read to collect the candidate blocks. These blocks are then
+read from the merged blocks files until the transaction is found. Only the blocks covered by the index
+are searched.`,
+	Args:    cobra.ExactArgs(3),
+	RunE:    txE,
+	Example: "firecosmos tools tx gs://my-bucket/merged-blocks gs://my-bucket/indexes 9D3C4AE0A5D1ED1C9BDBA6B6F4B5AE2EFBD9C3DB6B04E9C8A0A6F43F1D1D0C1C",
+}
+
+func init() {
+	txCmd.Flags().Int("parallel", 8, "Number of index files read concurrently")
+
+	Cmd.AddCommand(txCmd)
+}
+
+func txE(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	blocksStoreURL := args[0]
+	indexStoreURL := args[1]
+	hash, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(args[2]), "0x"))
+	if err != nil || len(hash) == 0 {
+		return fmt.Errorf("invalid tx hash %q, expected hex", args[2])
+	}
+	parallel, err := cmd.Flags().GetInt("parallel")
+	if err != nil {
+		return err
+	}
+	if parallel < 1 {
+		return fmt.Errorf("invalid parallel value %d, must be at least 1", parallel)
+	}
+
+	mergedBlocksStore, err := dstore.NewDBinStore(blocksStoreURL)
+	if err != nil {
+		return fmt.Errorf("failed setting up block store from url %q: %w", blocksStoreURL, err)
+	}
+	indexStore, err := dstore.NewStore(indexStoreURL, "", "", false)
+	if err != nil {
+		return fmt.Errorf("failed setting up an index store from url %q: %w", indexStoreURL, err)
+	}
+	cmd.SilenceUsage = true
+
+	candidates, err := txHashCandidates(ctx, indexStore, hash, parallel)
+	if err != nil {
+		return err
+	}
+	zlog.Info("found candidate blocks", zap.Uint64("count", candidates.GetCardinality()))
+
+	found, err := findTransaction(ctx, mergedBlocksStore, candidates, hash)
+	if err != nil {
+		return err
+	}
+	if found == nil {
+		return fmt.Errorf("transaction %X not found in the indexed blocks", hash)
+	}
+
+	// Messages are printed as raw type URL and value, their types are not known to firecosmos
+	out, err := prototext.MarshalOptions{Multiline: true}.Marshal(found)
+	if err != nil {
+		return fmt.Errorf("marshalling transaction: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// txHashCandidates returns the blocks which include a transaction with the same hash prefix, according to the files
+// of the index shard of the hash
+func txHashCandidates(ctx context.Context, indexStore dstore.Store, hash []byte, parallel int) (*roaring64.Bitmap, error) {
+	key := transform.TxHashIndexKey(hash)
+	shard := transform.TxHashIndexShard(key)
+	shardStore, err := transform.IndexShardStore(indexStore, transform.TxHashIndexShortName, shard)
+	if err != nil {
+		return nil, err
+	}
+	files, _, err := transform.ListIndexFiles(ctx, shardStore)
+	if err != nil {
+		return nil, fmt.Errorf("listing index files of shard %s: %w", shard, err)
+	}
+
+	// Index files of different sizes may cover the same blocks, the largest ones are read
+	var selected []transform.IndexFile
+	coveredStop := uint64(0)
+	for _, f := range largestFirst(files, transform.TxHashIndexShortName) {
+		if f.BaseBlockNum < coveredStop {
+			continue
+		}
+		selected = append(selected, f)
+		coveredStop = f.StopBlockNum()
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no %q index files found in shard %s", transform.TxHashIndexShortName, shard)
+	}
+	zlog.Info("reading index files", zap.String("shard", shard), zap.Int("count", len(selected)), zap.Uint64("stop_block", coveredStop))
+
+	out := roaring64.NewBitmap()
+	var lock sync.Mutex
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(parallel)
+	for _, f := range selected {
+		f := f
+		eg.Go(func() error {
+			bitmaps, err := readIndexFile(egCtx, shardStore, f)
+			if err != nil {
+				return err
+			}
+			if bm := bitmaps[key]; bm != nil {
+				lock.Lock()
+				out.Or(bm)
+				lock.Unlock()
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// largestFirst returns the index files of shortName sorted by base block num, the largest size first
+func largestFirst(files []transform.IndexFile, shortName string) []transform.IndexFile {
+	var out []transform.IndexFile
+	for _, f := range files {
+		if f.ShortName == shortName {
+			out = append(out, f)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].BaseBlockNum != out[j].BaseBlockNum {
+			return out[i].BaseBlockNum < out[j].BaseBlockNum
+		}
+		return out[i].Size > out[j].Size
+	})
+	return out
+}
+
+// findTransaction reads the candidate blocks, in order, until one of them includes the transaction
+func findTransaction(ctx context.Context, store dstore.Store, candidates *roaring64.Bitmap, hash []byte) (*pbfctype.BlockTransaction, error) {
+	readBundles := make(map[uint64]bool)
+	for _, blockNum := range candidates.ToArray() {
+		baseNum := bundleBaseNum(blockNum)
+		if readBundles[baseNum] {
+			continue
+		}
+		readBundles[baseNum] = true
+
+		blocks, err := readMergedBundle(ctx, store, baseNum)
+		if err != nil {
+			return nil, err
+		}
+		for _, blk := range blocks {
+			if !candidates.Contains(blk.Number) {
+				continue
+			}
+			block := blk.ToProtocol().(*pbcosmos.Block)
+			for _, tx := range block.Transactions {
+				if bytes.Equal(tx.Hash, hash) {
+					return &pbfctype.BlockTransaction{Header: block.Header, Tx: tx}, nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	"github.com/graphprotocol/firehose-cosmos/transform"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxLookup(t *testing.T) {
+	ctx := context.Background()
+	found := []byte{0x9d, 0x3c, 0x4a, 0xe0, 0xa5, 0xd1}
+	// Same prefix as found, in another block
+	collision := []byte{0x9d, 0x3c, 0x4a, 0xe0, 0xff, 0xff}
+
+	indexStore, err := dstore.NewStore(t.TempDir(), "", "", false)
+	require.NoError(t, err)
+	blocksStore, err := dstore.NewDBinStore(t.TempDir())
+	require.NoError(t, err)
+
+	indexer, err := transform.NewTxHashIndexer(indexStore, 100, 0)
+	require.NoError(t, err)
+	var blocks []*bstream.Block
+	for height := uint64(0); height <= 100; height++ {
+		block := &pbcosmos.Block{Header: &pbcosmos.Header{
+			Height: height,
+			Hash:   []byte{byte(height)},
+			Time:   &pbcosmos.Timestamp{Seconds: 1663000000 + int64(height)},
+		}}
+		switch height {
+		case 42:
+			block.Transactions = []*pbcosmos.TxResult{{Index: 0, Hash: []byte{0x01}}, {Index: 1, Hash: found}}
+		case 57:
+			block.Transactions = []*pbcosmos.TxResult{{Index: 0, Hash: collision}}
+		}
+		indexer.ProcessBlock(block)
+
+		if height < 100 {
+			blk, err := codec.FromProto(block)
+			require.NoError(t, err)
+			blocks = append(blocks, blk)
+		}
+	}
+	require.NoError(t, writeMergedBundle(ctx, blocksStore, 0, blocks))
+
+	candidates, err := txHashCandidates(ctx, indexStore, found, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{42, 57}, candidates.ToArray())
+
+	// The candidate blocks are checked against the full hash
+	tx, err := findTransaction(ctx, blocksStore, candidates, found)
+	require.NoError(t, err)
+	require.NotNil(t, tx)
+	assert.Equal(t, uint64(42), tx.Header.Height)
+	assert.Equal(t, uint32(1), tx.Tx.Index)
+
+	tx, err = findTransaction(ctx, blocksStore, candidates, collision)
+	require.NoError(t, err)
+	require.NotNil(t, tx)
+	assert.Equal(t, uint64(57), tx.Header.Height)
+
+	tx, err = findTransaction(ctx, blocksStore, candidates, []byte{0x9d, 0x3c, 0x4a, 0xe0, 0x00})
+	require.NoError(t, err)
+	assert.Nil(t, tx)
+
+	// Every shard holds the files of the indexed ranges
+	candidates, err = txHashCandidates(ctx, indexStore, []byte{0x02, 0x03}, 2)
+	require.NoError(t, err)
+	assert.True(t, candidates.IsEmpty())
+
+	emptyStore, err := dstore.NewStore(t.TempDir(), "", "", false)
+	require.NoError(t, err)
+	_, err = txHashCandidates(ctx, emptyStore, found, 2)
+	require.Error(t, err)
+}
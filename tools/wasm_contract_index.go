@@ -0,0 +1,85 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/graphprotocol/firehose-cosmos/transform"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose"
+)
+
+var generateWasmContractIdxCmd = &cobra.Command{
+	Use:   "generate-wasm-contract-index {index-url} {source-blocks-url} {start-block-num} [stop-block-num]",
+	Short: "Generate index files for CosmWasm contract addresses present in blocks",
+	Args:  cobra.RangeArgs(3, 4),
+	RunE:  generateWasmContractIdxE,
+}
+
+func init() {
+	generateWasmContractIdxCmd.Flags().Uint64("first-streamable-block", 0, "first streamable block of this chain")
+	generateWasmContractIdxCmd.Flags().Uint64("indexes-size", 10000, "size of index bundles that will be created")
+	generateWasmContractIdxCmd.Flags().IntSlice("lookup-indexes-sizes", []int{1000000, 100000, 10000, 1000}, "index bundle sizes that we will look for on start to find first unindexed block (should include indexes-size)")
+
+	Cmd.AddCommand(generateWasmContractIdxCmd)
+}
+
+func generateWasmContractIdxE(cmd *cobra.Command, args []string) error {
+	var err error
+	bstream.GetProtocolFirstStreamableBlock, err = cmd.Flags().GetUint64("first-streamable-block")
+	if err != nil {
+		return err
+	}
+	idxSize, err := cmd.Flags().GetUint64("indexes-size")
+	if err != nil {
+		return err
+	}
+	lookupIdxSizes, err := getLookupIndexesSizes(cmd)
+	if err != nil {
+		return err
+	}
+
+	indexStoreURL := args[0]
+	blocksStoreURL := args[1]
+	startBlockNum, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse block number %q: %w", args[2], err)
+	}
+	var stopBlockNum uint64
+	if len(args) == 4 {
+		stopBlockNum, err = strconv.ParseUint(args[3], 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to parse block number %q: %w", args[3], err)
+		}
+	}
+
+	mergedBlocksStore, err := dstore.NewDBinStore(blocksStoreURL)
+	if err != nil {
+		return fmt.Errorf("failed setting up block store from url %q: %w", blocksStoreURL, err)
+	}
+
+	indexStore, err := dstore.NewStore(indexStoreURL, "", "", false)
+	if err != nil {
+		return fmt.Errorf("failed setting up an index store from url %q: %w", indexStoreURL, err)
+	}
+
+	streamFactory := firehose.NewStreamFactory(
+		mergedBlocksStore,
+		nil,
+		nil,
+		nil,
+	)
+	cmd.SilenceUsage = true
+
+	shard := indexShard{start: startBlockNum, stop: stopBlockNum}
+	if err := runIndexShard(context.Background(), streamFactory, indexStore, shard, []string{transform.WasmContractIndexShortName}, idxSize, lookupIdxSizes); err != nil {
+		return err
+	}
+
+	zlog.Info("complete")
+	return nil
+}
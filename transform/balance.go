@@ -0,0 +1,151 @@
+package transform
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	coinSpentEventType    = "coin_spent"
+	coinReceivedEventType = "coin_received"
+	transferEventType     = "transfer"
+)
+
+// coinRegex matches the coins of the amount attributes of the bank events, as formatted by the Cosmos SDK
+var coinRegex = regexp.MustCompile(`^([0-9]+)([a-zA-Z][a-zA-Z0-9/:._-]{2,127})$`)
+
+// Coin is an amount of a denom
+type Coin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+// ParseCoins parses a comma separated list of coins, ex: `100uatom,5ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2`
+func ParseCoins(value string) ([]Coin, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	var out []Coin
+	for _, part := range strings.Split(value, ",") {
+		matches := coinRegex.FindStringSubmatch(strings.TrimSpace(part))
+		if matches == nil {
+			return nil, fmt.Errorf("invalid coin %q", part)
+		}
+		amount, _ := new(big.Int).SetString(matches[1], 10)
+		out = append(out, Coin{Amount: amount, Denom: matches[2]})
+	}
+	return out, nil
+}
+
+// BalanceChanges derives the balance changes of the block from the events of the bank module
+func BalanceChanges(block *pbcosmos.Block) *pbfctype.BalanceChangeBlock {
+	out := &pbfctype.BalanceChangeBlock{}
+	if block.Header != nil {
+		out.Height = block.Header.Height
+		out.Hash = block.Header.Hash
+		if block.Header.Time != nil {
+			out.Time = &timestamppb.Timestamp{Seconds: block.Header.Time.Seconds, Nanos: block.Header.Time.Nanos}
+		}
+	}
+
+	if block.ResultBeginBlock != nil {
+		out.Changes = append(out.Changes, balanceChangesFromEvents(block.ResultBeginBlock.Events, pbfctype.EventOrigin_EVENT_ORIGIN_BEGIN_BLOCK, nil)...)
+	}
+	for _, tx := range block.Transactions {
+		if tx.Result == nil {
+			continue
+		}
+		out.Changes = append(out.Changes, balanceChangesFromEvents(tx.Result.Events, pbfctype.EventOrigin_EVENT_ORIGIN_DELIVER_TX, tx)...)
+	}
+	if block.ResultEndBlock != nil {
+		out.Changes = append(out.Changes, balanceChangesFromEvents(block.ResultEndBlock.Events, pbfctype.EventOrigin_EVENT_ORIGIN_END_BLOCK, nil)...)
+	}
+
+	return out
+}
+
+// balanceChangesFromEvents sums the changes of each address and denom, in order of first appearance. The
+// `coin_spent` and `coin_received` events are used, they are emitted since v0.44. For earlier versions, the changes
+// are derived from the `transfer` events, which do not include minted and burned coins.
+func balanceChangesFromEvents(events []*pbcosmos.Event, origin pbfctype.EventOrigin, tx *pbcosmos.TxResult) []*pbfctype.BalanceChange {
+	hasCoinEvents := false
+	for _, event := range events {
+		if event.EventType == coinSpentEventType || event.EventType == coinReceivedEventType {
+			hasCoinEvents = true
+			break
+		}
+	}
+
+	type balanceKey struct{ address, denom string }
+	var keys []balanceKey
+	deltas := make(map[balanceKey]*big.Int)
+	add := func(address, amount string, negate bool) {
+		if address == "" {
+			return
+		}
+		coins, err := ParseCoins(amount)
+		if err != nil {
+			zlog.Debug("skipping invalid bank event amount", zap.String("amount", amount), zap.Error(err))
+			return
+		}
+		for _, coin := range coins {
+			key := balanceKey{address, coin.Denom}
+			delta, found := deltas[key]
+			if !found {
+				delta = new(big.Int)
+				deltas[key] = delta
+				keys = append(keys, key)
+			}
+			if negate {
+				delta.Sub(delta, coin.Amount)
+			} else {
+				delta.Add(delta, coin.Amount)
+			}
+		}
+	}
+
+	for _, event := range events {
+		amount, _ := eventAttribute(event, "amount")
+		switch {
+		case hasCoinEvents && event.EventType == coinSpentEventType:
+			spender, _ := eventAttribute(event, "spender")
+			add(spender, amount, true)
+		case hasCoinEvents && event.EventType == coinReceivedEventType:
+			receiver, _ := eventAttribute(event, "receiver")
+			add(receiver, amount, false)
+		case !hasCoinEvents && event.EventType == transferEventType:
+			sender, _ := eventAttribute(event, "sender")
+			recipient, _ := eventAttribute(event, "recipient")
+			add(sender, amount, true)
+			add(recipient, amount, false)
+		}
+	}
+
+	var out []*pbfctype.BalanceChange
+	for _, key := range keys {
+		if deltas[key].Sign() == 0 {
+			continue
+		}
+		change := &pbfctype.BalanceChange{
+			Address: key.address,
+			Denom:   key.denom,
+			Amount:  deltas[key].String(),
+			Origin:  origin,
+		}
+		if tx != nil {
+			change.TxHash = tx.Hash
+			change.TxIndex = tx.Index
+		}
+		out = append(out, change)
+	}
+	return out
+}
@@ -0,0 +1,103 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+var BalanceChangeFilterMessageName = proto.MessageName(&pbfctransform.BalanceChangeFilter{})
+
+func BalanceChangeFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint64) *transform.Factory {
+	return &transform.Factory{
+		Obj: &pbfctransform.BalanceChangeFilter{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			if message.MessageName() != BalanceChangeFilterMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", BalanceChangeFilterMessageName, message.TypeUrl)
+			}
+
+			filter := &pbfctransform.BalanceChangeFilter{}
+			err := proto.Unmarshal(message.Value, filter)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected unmarshal error: %w", err)
+			}
+
+			addressMap := make(map[string]bool)
+			for _, address := range filter.Addresses {
+				addressMap[address] = true
+			}
+
+			denomMap := make(map[string]bool)
+			for _, denom := range filter.Denoms {
+				denomMap[denom] = true
+			}
+
+			return &BalanceChangeFilter{
+				Addresses:          addressMap,
+				Denoms:             denomMap,
+				possibleIndexSizes: possibleIndexSizes,
+				indexStore:         indexStore,
+			}, nil
+		},
+	}
+}
+
+// BalanceChangeFilter outputs the balance changes of the block for the Addresses and the Denoms, each set being
+// ignored when empty
+type BalanceChangeFilter struct {
+	Addresses map[string]bool
+	Denoms    map[string]bool
+
+	indexStore         dstore.Store
+	possibleIndexSizes []uint64
+}
+
+func (p *BalanceChangeFilter) String() string {
+	return fmt.Sprintf("addresses: %v, denoms: %v", p.Addresses, p.Denoms)
+}
+
+func (p *BalanceChangeFilter) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
+
+	out := BalanceChanges(block)
+	if len(p.Addresses) == 0 && len(p.Denoms) == 0 {
+		return out, nil
+	}
+
+	changes := out.Changes
+	out.Changes = nil
+	for _, change := range changes {
+		if len(p.Addresses) > 0 && !p.Addresses[change.Address] {
+			continue
+		}
+		if len(p.Denoms) > 0 && !matchesType(p.Denoms, change.Denom) {
+			continue
+		}
+		out.Changes = append(out.Changes, change)
+	}
+
+	return out, nil
+}
+
+func (p *BalanceChangeFilter) GetIndexProvider() bstream.BlockIndexProvider {
+	if p.indexStore == nil {
+		return nil
+	}
+
+	if !indexableTypes(p.Denoms) {
+		return nil
+	}
+
+	return NewDenomIndexProvider(
+		p.indexStore,
+		p.possibleIndexSizes,
+		p.Denoms,
+	)
+}
@@ -0,0 +1,168 @@
+package transform
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const minter = "cosmos1m3h30wlvsf8llruxtpukdvsy0km2kum8g38c8q"
+
+func balanceBlock() *pbcosmos.Block {
+	sendTx := &pbcosmos.TxResult{
+		Index: 0,
+		Hash:  []byte{0xaa},
+		Result: &pbcosmos.ResponseDeliverTx{Events: append(anteEvents(), withMsgIndex("0",
+			event("coin_spent", "spender", delegator, "amount", "150000uatom,5"+ibcDenom),
+			event("coin_received", "receiver", recipient, "amount", "150000uatom,5"+ibcDenom),
+			event("transfer", "recipient", recipient, "sender", delegator, "amount", "150000uatom,5"+ibcDenom),
+		)...)},
+	}
+
+	// Cosmos SDK v0.42 transaction, which only has transfer events
+	legacyTx := &pbcosmos.TxResult{
+		Index: 1,
+		Hash:  []byte{0xbb},
+		Result: &pbcosmos.ResponseDeliverTx{Events: []*pbcosmos.Event{
+			event("transfer", "recipient", feeCollector, "sender", recipient, "amount", "500uatom"),
+			event("transfer", "recipient", delegator, "sender", recipient, "amount", "1000uatom"),
+		}},
+	}
+
+	return &pbcosmos.Block{
+		Header: &pbcosmos.Header{Height: 12000002, Hash: []byte{0x09}, Time: &pbcosmos.Timestamp{Seconds: 1663000000}},
+		ResultBeginBlock: &pbcosmos.ResponseBeginBlock{Events: []*pbcosmos.Event{
+			event("coin_received", "receiver", minter, "amount", "7000000uatom"),
+			event("mint", "amount", "7000000uatom"),
+			event("coin_spent", "spender", minter, "amount", "7000000uatom"),
+			event("coin_received", "receiver", feeCollector, "amount", "7000000uatom"),
+		}},
+		ResultEndBlock: &pbcosmos.ResponseEndBlock{Events: []*pbcosmos.Event{
+			event("coin_spent", "spender", bondedPool, "amount", "1uatom"),
+			event("coin_received", "receiver", delegator, "amount", "1uatom"),
+		}},
+		Transactions: []*pbcosmos.TxResult{sendTx, legacyTx},
+	}
+}
+
+func balanceChangeStrings(changes []*pbfctype.BalanceChange) (out []string) {
+	for _, change := range changes {
+		out = append(out, change.Origin.String()+" "+change.Address+" "+change.Amount+change.Denom)
+	}
+	return out
+}
+
+func TestBalanceChanges(t *testing.T) {
+	changes := BalanceChanges(balanceBlock()).Changes
+
+	assert.Equal(t, []string{
+		"EVENT_ORIGIN_BEGIN_BLOCK " + feeCollector + " 7000000uatom",
+		"EVENT_ORIGIN_DELIVER_TX " + delegator + " -152500uatom",
+		"EVENT_ORIGIN_DELIVER_TX " + feeCollector + " 2500uatom",
+		"EVENT_ORIGIN_DELIVER_TX " + delegator + " -5" + ibcDenom,
+		"EVENT_ORIGIN_DELIVER_TX " + recipient + " 150000uatom",
+		"EVENT_ORIGIN_DELIVER_TX " + recipient + " 5" + ibcDenom,
+		"EVENT_ORIGIN_DELIVER_TX " + recipient + " -1500uatom",
+		"EVENT_ORIGIN_DELIVER_TX " + feeCollector + " 500uatom",
+		"EVENT_ORIGIN_DELIVER_TX " + delegator + " 1000uatom",
+		"EVENT_ORIGIN_END_BLOCK " + bondedPool + " -1uatom",
+		"EVENT_ORIGIN_END_BLOCK " + delegator + " 1uatom",
+	}, balanceChangeStrings(changes))
+
+	assert.Equal(t, []byte{0xaa}, changes[1].TxHash)
+	assert.Equal(t, uint32(1), changes[6].TxIndex)
+	assert.Nil(t, changes[0].TxHash)
+}
+
+func TestBalanceChangeFilter_Transform(t *testing.T) {
+	tests := []struct {
+		name            string
+		addresses       []string
+		denoms          []string
+		expectedChanges []string
+	}{
+		{
+			name:      "address",
+			addresses: []string{recipient},
+			expectedChanges: []string{
+				"EVENT_ORIGIN_DELIVER_TX " + recipient + " 150000uatom",
+				"EVENT_ORIGIN_DELIVER_TX " + recipient + " 5" + ibcDenom,
+				"EVENT_ORIGIN_DELIVER_TX " + recipient + " -1500uatom",
+			},
+		},
+		{
+			name:   "denom pattern",
+			denoms: []string{"ibc/*"},
+			expectedChanges: []string{
+				"EVENT_ORIGIN_DELIVER_TX " + delegator + " -5" + ibcDenom,
+				"EVENT_ORIGIN_DELIVER_TX " + recipient + " 5" + ibcDenom,
+			},
+		},
+		{
+			name:      "address and denom",
+			addresses: []string{delegator},
+			denoms:    []string{"uatom"},
+			expectedChanges: []string{
+				"EVENT_ORIGIN_DELIVER_TX " + delegator + " -152500uatom",
+				"EVENT_ORIGIN_DELIVER_TX " + delegator + " 1000uatom",
+				"EVENT_ORIGIN_END_BLOCK " + delegator + " 1uatom",
+			},
+		},
+		{
+			name:   "unknown denom",
+			denoms: []string{"uosmo"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blk, err := codec.FromProto(balanceBlock())
+			require.NoError(t, err)
+
+			filter := &BalanceChangeFilter{Addresses: make(map[string]bool), Denoms: make(map[string]bool)}
+			for _, address := range test.addresses {
+				filter.Addresses[address] = true
+			}
+			for _, denom := range test.denoms {
+				filter.Denoms[denom] = true
+			}
+
+			output, err := filter.Transform(blk, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedChanges, balanceChangeStrings(output.(*pbfctype.BalanceChangeBlock).Changes))
+		})
+	}
+}
+
+func TestDenomIndexer(t *testing.T) {
+	keys, err := IndexKeys(DenomIndexShortName, balanceBlock())
+	require.NoError(t, err)
+
+	sort.Strings(keys)
+	assert.Equal(t, []string{ibcDenom, "uatom"}, keys)
+}
+
+func TestParseCoins(t *testing.T) {
+	coins, err := ParseCoins("100uatom,5" + ibcDenom + ",1000000000000000000000000aevmos")
+	require.NoError(t, err)
+	require.Len(t, coins, 3)
+	assert.Equal(t, "uatom", coins[0].Denom)
+	assert.Equal(t, "100", coins[0].Amount.String())
+	assert.Equal(t, ibcDenom, coins[1].Denom)
+	assert.Equal(t, "1000000000000000000000000", coins[2].Amount.String())
+
+	coins, err = ParseCoins("")
+	require.NoError(t, err)
+	assert.Empty(t, coins)
+
+	_, err = ParseCoins("uatom")
+	assert.Error(t, err)
+	_, err = ParseCoins("1.5uatom")
+	assert.Error(t, err)
+}
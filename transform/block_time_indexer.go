@@ -0,0 +1,61 @@
+package transform
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+const BlockTimeIndexShortName = "blocktime"
+
+func init() {
+	RegisterIndexer(BlockTimeIndexShortName, func(blockIndexer BlockIndexer) Indexer {
+		return &BlockTimeIndexer{BlockIndexer: blockIndexer}
+	})
+}
+
+// BlockTimeIndexer indexes each block under its header time, as nanoseconds since the unix epoch. Block times are
+// strictly increasing so every key holds a single block, the index is used to resolve times to block numbers.
+type BlockTimeIndexer struct {
+	BlockIndexer BlockIndexer
+}
+
+func NewBlockTimeIndexer(indexStore dstore.Store, indexSize uint64, startBlock uint64) *BlockTimeIndexer {
+	bi := transform.NewBlockIndexer(
+		indexStore,
+		indexSize,
+		BlockTimeIndexShortName,
+		transform.WithDefinedStartBlock(startBlock),
+	)
+
+	return &BlockTimeIndexer{
+		BlockIndexer: bi,
+	}
+}
+
+func (i *BlockTimeIndexer) ProcessBlock(block *pbcosmos.Block) {
+	var keys []string
+	if block.Header.Time != nil {
+		keys = append(keys, BlockTimeIndexKey(time.Unix(block.Header.Time.Seconds, int64(block.Header.Time.Nanos))))
+	}
+
+	i.BlockIndexer.Add(keys, block.Header.Height)
+}
+
+// BlockTimeIndexKey returns the key of the blocks produced at t in the block time index
+func BlockTimeIndexKey(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// ParseBlockTimeIndexKey returns the time of a key of the block time index
+func ParseBlockTimeIndexKey(key string) (time.Time, error) {
+	nanos, err := strconv.ParseInt(key, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid block time index key %q: %w", key, err)
+	}
+	return time.Unix(0, nanos).UTC(), nil
+}
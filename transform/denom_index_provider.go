@@ -0,0 +1,27 @@
+package transform
+
+import (
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+const DenomIndexShortName = "denom"
+
+func NewDenomIndexProvider(
+	store dstore.Store,
+	possibleIndexSizes []uint64,
+	denoms map[string]bool,
+) *transform.GenericBlockIndexProvider {
+	return transform.NewGenericBlockIndexProvider(
+		store,
+		DenomIndexShortName,
+		possibleIndexSizes,
+		getDenomFilterFunc(denoms),
+	)
+}
+
+func getDenomFilterFunc(denoms map[string]bool) func(transform.BitmapGetter) []uint64 {
+	return func(bitmaps transform.BitmapGetter) (matchingBlocks []uint64) {
+		return nilIfEmpty(typeBitmap(bitmaps, denoms).ToArray())
+	}
+}
@@ -0,0 +1,45 @@
+package transform
+
+import (
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+func init() {
+	RegisterIndexer(DenomIndexShortName, func(blockIndexer BlockIndexer) Indexer {
+		return &DenomIndexer{BlockIndexer: blockIndexer}
+	})
+}
+
+// DenomIndexer indexes the denoms of the balance changes of each block
+type DenomIndexer struct {
+	BlockIndexer BlockIndexer
+}
+
+func NewDenomIndexer(indexStore dstore.Store, indexSize uint64, startBlock uint64) *DenomIndexer {
+	bi := transform.NewBlockIndexer(
+		indexStore,
+		indexSize,
+		DenomIndexShortName,
+		transform.WithDefinedStartBlock(startBlock),
+	)
+
+	return &DenomIndexer{
+		BlockIndexer: bi,
+	}
+}
+
+func (i *DenomIndexer) ProcessBlock(block *pbcosmos.Block) {
+	keyMap := make(map[string]bool)
+	for _, change := range BalanceChanges(block).Changes {
+		keyMap[change.Denom] = true
+	}
+
+	var keys []string
+	for key := range keyMap {
+		keys = append(keys, key)
+	}
+
+	i.BlockIndexer.Add(keys, block.Header.Height)
+}
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
 	pbtransform "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/transform/v1"
 	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
 )
@@ -67,6 +68,18 @@ func (p *EventOriginFilter) String() string {
 }
 
 func (p *EventOriginFilter) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	// Following an EventsOnly transform, its flattened events are filtered instead of the block
+	if eventsBlock, ok := flattenedEvents(readOnlyBlk); ok {
+		var events []*pbfctype.FlatEvent
+		for _, event := range eventsBlock.Events {
+			if p.EventOrigins[EventOrigin(event.Origin)] {
+				events = append(events, event)
+			}
+		}
+		eventsBlock.Events = events
+		return eventsBlock, nil
+	}
+
 	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
 
 	// if filter doesn't pass these Event Origins, nullify the objects in the block
@@ -6,8 +6,14 @@ import (
 	"github.com/streamingfast/dstore"
 )
 
+func init() {
+	RegisterIndexer(EventOriginIndexShortName, func(blockIndexer BlockIndexer) Indexer {
+		return &EventOriginIndexer{BlockIndexer: blockIndexer}
+	})
+}
+
 type EventOriginIndexer struct {
-	BlockIndexer blockIndexer
+	BlockIndexer BlockIndexer
 }
 
 func NewEventOriginIndexer(indexStore dstore.Store, indexSize uint64, startBlock uint64) *EventOriginIndexer {
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
 	pbtransform "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/transform/v1"
 	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
 )
@@ -48,7 +49,7 @@ func EventTypeFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint64
 }
 
 type EventTypeFilter struct {
-	EventTypes map[string]bool
+	EventTypes map[string]bool // may hold patterns, see TypeWildcard
 
 	indexStore         dstore.Store
 	possibleIndexSizes []uint64
@@ -59,6 +60,18 @@ func (p *EventTypeFilter) String() string {
 }
 
 func (p *EventTypeFilter) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	// Following an EventsOnly transform, its flattened events are filtered instead of the block
+	if eventsBlock, ok := flattenedEvents(readOnlyBlk); ok {
+		var events []*pbfctype.FlatEvent
+		for _, event := range eventsBlock.Events {
+			if matchesType(p.EventTypes, event.Event.EventType) {
+				events = append(events, event)
+			}
+		}
+		eventsBlock.Events = events
+		return eventsBlock, nil
+	}
+
 	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
 
 	block.ResultBeginBlock.Events = p.filterEvents(block.ResultBeginBlock.Events)
@@ -75,7 +88,7 @@ func (p *EventTypeFilter) filterEvents(events []*pbcosmos.Event) []*pbcosmos.Eve
 	var outEvents []*pbcosmos.Event
 
 	for _, event := range events {
-		if p.EventTypes[event.EventType] {
+		if matchesType(p.EventTypes, event.EventType) {
 			outEvents = append(outEvents, event)
 		}
 	}
@@ -88,7 +101,7 @@ func (p *EventTypeFilter) GetIndexProvider() bstream.BlockIndexProvider {
 		return nil
 	}
 
-	if len(p.EventTypes) == 0 {
+	if !indexableTypes(p.EventTypes) {
 		return nil
 	}
 
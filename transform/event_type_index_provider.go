@@ -1,7 +1,6 @@
 package transform
 
 import (
-	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/streamingfast/bstream/transform"
 	"github.com/streamingfast/dstore"
 )
@@ -23,13 +22,7 @@ func NewEventTypeIndexProvider(
 
 func getFilterFunc(eventTypes map[string]bool) func(transform.BitmapGetter) []uint64 {
 	return func(bitmaps transform.BitmapGetter) (matchingBlocks []uint64) {
-		out := roaring64.NewBitmap()
-		for et := range eventTypes {
-			if bm := bitmaps.Get(et); bm != nil {
-				out.Or(bm)
-			}
-		}
-		return nilIfEmpty(out.ToArray())
+		return nilIfEmpty(typeBitmap(bitmaps, eventTypes).ToArray())
 	}
 }
 
@@ -6,12 +6,14 @@ import (
 	"github.com/streamingfast/dstore"
 )
 
-type blockIndexer interface {
-	Add(keys []string, blockNum uint64)
+func init() {
+	RegisterIndexer(EventTypeIndexShortName, func(blockIndexer BlockIndexer) Indexer {
+		return &EventTypeIndexer{BlockIndexer: blockIndexer}
+	})
 }
 
 type EventTypeIndexer struct {
-	BlockIndexer blockIndexer
+	BlockIndexer BlockIndexer
 }
 
 func NewEventTypeIndexer(indexStore dstore.Store, indexSize uint64, startBlock uint64) *EventTypeIndexer {
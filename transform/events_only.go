@@ -0,0 +1,132 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+var EventsOnlyMessageName = proto.MessageName(&pbfctransform.EventsOnly{})
+
+func EventsOnlyFactory() *transform.Factory {
+	return &transform.Factory{
+		Obj: &pbfctransform.EventsOnly{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			if message.MessageName() != EventsOnlyMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", EventsOnlyMessageName, message.TypeUrl)
+			}
+
+			return &EventsOnly{}, nil
+		},
+	}
+}
+
+// EventsOnly replaces the blocks with an EventsBlock holding their flattened events. It must run on the unfiltered
+// block for the event identifiers to be stable, the event type and event origin filters applied after it filter the
+// flattened events.
+type EventsOnly struct{}
+
+func (p *EventsOnly) String() string {
+	return "events only"
+}
+
+func (p *EventsOnly) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	// The transforms are given a clone of the block, which payload is dropped once decoded by the first of them
+	if readOnlyBlk.Payload == nil {
+		return nil, fmt.Errorf("events only transform must be the first transform, the block was decoded by another one")
+	}
+
+	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
+	eventsBlock := FlattenEvents(block)
+	readOnlyBlk.Payload = &eventsPayload{eventsBlock: eventsBlock}
+	return eventsBlock, nil
+}
+
+// FlattenEvents lists the events of the block in execution order
+func FlattenEvents(block *pbcosmos.Block) *pbfctype.EventsBlock {
+	out := &pbfctype.EventsBlock{}
+	if block.Header != nil {
+		out.Height = block.Header.Height
+		out.Hash = block.Header.Hash
+		if block.Header.Time != nil {
+			out.Time = &timestamppb.Timestamp{Seconds: block.Header.Time.Seconds, Nanos: block.Header.Time.Nanos}
+		}
+	}
+
+	if block.ResultBeginBlock != nil {
+		out.Events = append(out.Events, flatEvents(out.Height, BeginBlock, block.ResultBeginBlock.Events, nil)...)
+	}
+	for _, tx := range block.Transactions {
+		if tx.Result == nil {
+			continue
+		}
+		out.Events = append(out.Events, flatEvents(out.Height, DeliverTx, tx.Result.Events, tx)...)
+	}
+	if block.ResultEndBlock != nil {
+		out.Events = append(out.Events, flatEvents(out.Height, EndBlock, block.ResultEndBlock.Events, nil)...)
+	}
+
+	return out
+}
+
+func flatEvents(height uint64, origin EventOrigin, events []*pbcosmos.Event, tx *pbcosmos.TxResult) []*pbfctype.FlatEvent {
+	var msgIndexes []int
+	correlated := false
+	var txIndex uint32
+	if tx != nil {
+		msgIndexes, correlated = EventMessageIndexes(tx.Result)
+		txIndex = tx.Index
+	}
+
+	out := make([]*pbfctype.FlatEvent, 0, len(events))
+	for i, event := range events {
+		flat := &pbfctype.FlatEvent{
+			Id:         EventID(height, origin, txIndex, uint32(i)),
+			Origin:     string(origin),
+			TxIndex:    txIndex,
+			MsgIndex:   txLevelEvent,
+			EventIndex: uint32(i),
+			Event:      event,
+		}
+		if tx != nil {
+			flat.TxHash = tx.Hash
+		}
+		if correlated {
+			flat.MsgIndex = int32(msgIndexes[i])
+		}
+		out = append(out, flat)
+	}
+	return out
+}
+
+// EventID formats the identifier of an event, the transaction index being 0 for the begin and end blocker events
+func EventID(height uint64, origin EventOrigin, txIndex uint32, eventIndex uint32) string {
+	return fmt.Sprintf("%d/%s/%d/%d", height, origin, txIndex, eventIndex)
+}
+
+// eventsPayload attaches the EventsBlock output by EventsOnly to the block it was flattened from, for the filters
+// following it. The input of the transforms cannot be used, as it is shared by the blocks processed concurrently.
+type eventsPayload struct {
+	eventsBlock *pbfctype.EventsBlock
+}
+
+func (p *eventsPayload) Get() ([]byte, error) {
+	return proto.Marshal(p.eventsBlock)
+}
+
+// flattenedEvents returns the EventsBlock output by a previous EventsOnly transform on the block, if any
+func flattenedEvents(blk *bstream.Block) (*pbfctype.EventsBlock, bool) {
+	payload, ok := blk.Payload.(*eventsPayload)
+	if !ok {
+		return nil, false
+	}
+	return payload.eventsBlock, true
+}
@@ -0,0 +1,228 @@
+package transform
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbtransform "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/transform/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func eventsBlock() *pbcosmos.Block {
+	restake := restakeTx()
+	restake.Index = 0
+	restake.Hash = []byte{0xaa}
+
+	vote := sendAndVoteTx()
+	vote.Index = 1
+	vote.Hash = []byte{0xbb}
+
+	return &pbcosmos.Block{
+		Header:           &pbcosmos.Header{Height: 12000004, Hash: []byte{0x0b}, Time: &pbcosmos.Timestamp{Seconds: 1663000000}},
+		ResultBeginBlock: &pbcosmos.ResponseBeginBlock{Events: []*pbcosmos.Event{event("mint", "amount", "7000000uatom")}},
+		ResultEndBlock:   &pbcosmos.ResponseEndBlock{Events: []*pbcosmos.Event{event("complete_unbonding", "amount", "2000uatom")}},
+		Transactions:     []*pbcosmos.TxResult{restake, vote},
+	}
+}
+
+func TestFlattenEvents(t *testing.T) {
+	events := FlattenEvents(eventsBlock()).Events
+	require.Len(t, events, 1+len(restakeTx().Result.Events)+len(sendAndVoteTx().Result.Events)+1)
+
+	first := events[0]
+	assert.Equal(t, "12000004/BeginBlock/0/0", first.Id)
+	assert.Equal(t, string(BeginBlock), first.Origin)
+	assert.Equal(t, int32(-1), first.MsgIndex)
+	assert.Equal(t, "mint", first.Event.EventType)
+
+	// Events of a v0.45 transaction, correlated with their message from the ABCI log
+	delegate := events[18]
+	assert.Equal(t, "12000004/DeliverTx/0/17", delegate.Id)
+	assert.Equal(t, "delegate", delegate.Event.EventType)
+	assert.Equal(t, []byte{0xaa}, delegate.TxHash)
+	assert.Equal(t, int32(1), delegate.MsgIndex)
+
+	vote := events[len(events)-2]
+	assert.Equal(t, "12000004/DeliverTx/1/13", vote.Id)
+	assert.Equal(t, "proposal_vote", vote.Event.EventType)
+	assert.Equal(t, uint32(1), vote.TxIndex)
+	assert.Equal(t, int32(1), vote.MsgIndex)
+
+	last := events[len(events)-1]
+	assert.Equal(t, "12000004/EndBlock/0/0", last.Id)
+	assert.Equal(t, string(EndBlock), last.Origin)
+}
+
+func TestEventsOnly_Composition(t *testing.T) {
+	registry := transform.NewRegistry()
+	registry.Register(EventsOnlyFactory())
+	registry.Register(EventTypeFilterFactory(nil, nil))
+	registry.Register(EventOriginFilterFactory(nil, nil))
+
+	tests := []struct {
+		name        string
+		transforms  []proto.Message
+		expectedAll bool
+		expectedIDs []string
+		expectedErr bool
+	}{
+		{
+			name:        "events only",
+			transforms:  []proto.Message{&pbfctransform.EventsOnly{}},
+			expectedAll: true,
+		},
+		{
+			name: "event type",
+			transforms: []proto.Message{
+				&pbfctransform.EventsOnly{},
+				&pbtransform.EventTypeFilter{EventTypes: []string{"delegate", "proposal_*", "mint"}},
+			},
+			expectedIDs: []string{"12000004/BeginBlock/0/0", "12000004/DeliverTx/0/17", "12000004/DeliverTx/1/13"},
+		},
+		{
+			name: "event type and origin",
+			transforms: []proto.Message{
+				&pbfctransform.EventsOnly{},
+				&pbtransform.EventTypeFilter{EventTypes: []string{"delegate", "proposal_*", "mint"}},
+				&pbtransform.EventOriginFilter{EventOrigins: []string{string(DeliverTx)}},
+			},
+			expectedIDs: []string{"12000004/DeliverTx/0/17", "12000004/DeliverTx/1/13"},
+		},
+		{
+			name: "filter before events only",
+			transforms: []proto.Message{
+				&pbtransform.EventTypeFilter{EventTypes: []string{"delegate"}},
+				&pbfctransform.EventsOnly{},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var anyTransforms []*anypb.Any
+			for _, message := range test.transforms {
+				anyTransform, err := anypb.New(message)
+				require.NoError(t, err)
+				anyTransforms = append(anyTransforms, anyTransform)
+			}
+
+			preprocess, _, _, err := registry.BuildFromTransforms(anyTransforms)
+			require.NoError(t, err)
+
+			blk, err := codec.FromProto(eventsBlock())
+			require.NoError(t, err)
+
+			output, err := preprocess(blk)
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			events := output.(*pbfctype.EventsBlock).Events
+			if test.expectedAll {
+				assert.Len(t, events, len(FlattenEvents(eventsBlock()).Events))
+				return
+			}
+
+			var ids []string
+			for _, event := range events {
+				ids = append(ids, event.Id)
+			}
+			assert.Equal(t, test.expectedIDs, ids)
+		})
+	}
+}
+
+func TestEventsOnly_Parallel(t *testing.T) {
+	registry := transform.NewRegistry()
+	registry.Register(EventsOnlyFactory())
+	registry.Register(EventTypeFilterFactory(nil, nil))
+	registry.Register(EventOriginFilterFactory(nil, nil))
+
+	var anyTransforms []*anypb.Any
+	for _, message := range []proto.Message{
+		&pbfctransform.EventsOnly{},
+		&pbtransform.EventTypeFilter{EventTypes: []string{"delegate", "proposal_*", "mint"}},
+		&pbtransform.EventOriginFilter{EventOrigins: []string{string(DeliverTx)}},
+	} {
+		anyTransform, err := anypb.New(message)
+		require.NoError(t, err)
+		anyTransforms = append(anyTransforms, anyTransform)
+	}
+	preprocess, _, _, err := registry.BuildFromTransforms(anyTransforms)
+	require.NoError(t, err)
+
+	// The blocks of a stream are preprocessed concurrently with the same transforms
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make(chan error, 200)
+	for height := uint64(1); height <= 200; height++ {
+		block := eventsBlock()
+		block.Header.Height = height
+		blk, err := codec.FromProto(block)
+		require.NoError(t, err)
+
+		wg.Add(1)
+		go func(height uint64, blk *bstream.Block) {
+			defer wg.Done()
+			<-start
+			output, err := preprocess(blk)
+			if err != nil {
+				errs <- err
+				return
+			}
+			var ids []string
+			for _, event := range output.(*pbfctype.EventsBlock).Events {
+				ids = append(ids, event.Id)
+			}
+			expected := []string{fmt.Sprintf("%d/DeliverTx/0/17", height), fmt.Sprintf("%d/DeliverTx/1/13", height)}
+			if fmt.Sprint(ids) != fmt.Sprint(expected) {
+				errs <- fmt.Errorf("block %d: got events %v, expected %v", height, ids, expected)
+			}
+		}(height, blk)
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
+func TestEventsOnly_InterleavedBlocks(t *testing.T) {
+	blocks := make([]*bstream.Block, 2)
+	for i := range blocks {
+		block := eventsBlock()
+		block.Header.Height = uint64(i + 1)
+		blk, err := codec.FromProto(block)
+		require.NoError(t, err)
+		blocks[i] = blk.Clone()
+	}
+
+	// The input of the transforms is shared by the blocks processed concurrently, the filters following EventsOnly
+	// can receive the input of another block starting its transforms
+	first, err := (&EventsOnly{}).Transform(blocks[0], transform.NewNilObj())
+	require.NoError(t, err)
+	_, err = (&EventsOnly{}).Transform(blocks[1], transform.NewNilObj())
+	require.NoError(t, err)
+
+	output, err := (&EventOriginFilter{EventOrigins: map[EventOrigin]bool{BeginBlock: true}}).Transform(blocks[0], transform.NewNilObj())
+	require.NoError(t, err)
+	assert.Same(t, first, output)
+	require.Len(t, output.(*pbfctype.EventsBlock).Events, 1)
+	assert.Equal(t, "1/BeginBlock/0/0", output.(*pbfctype.EventsBlock).Events[0].Id)
+}
@@ -0,0 +1,104 @@
+package transform
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// feeTxEventType is the type of the events emitted by the ante handler, holding the `fee_payer` (v0.46+) and
+	// `acc_seq` (`{address}/{sequence}` of each signer) attributes
+	feeTxEventType = "tx"
+	// feeGrantEventType is emitted by the ante handler when the fee is paid from a fee allowance
+	feeGrantEventType = "use_feegrant"
+)
+
+// FeeBlock extracts the fee and gas of each transaction of the block
+func FeeBlock(block *pbcosmos.Block) *pbfctype.FeeBlock {
+	out := &pbfctype.FeeBlock{}
+	if block.Header != nil {
+		out.Height = block.Header.Height
+		out.Hash = block.Header.Hash
+		if block.Header.Time != nil {
+			out.Time = &timestamppb.Timestamp{Seconds: block.Header.Time.Seconds, Nanos: block.Header.Time.Nanos}
+		}
+	}
+
+	totals := make(map[string]*big.Int)
+	for _, tx := range block.Transactions {
+		fee := txFee(tx)
+		out.Txs = append(out.Txs, fee)
+
+		out.TotalGasLimit += fee.GasLimit
+		out.TotalGasWanted += fee.GasWanted
+		out.TotalGasUsed += fee.GasUsed
+		for _, coin := range fee.Fee {
+			amount, ok := new(big.Int).SetString(coin.Amount, 10)
+			if !ok {
+				continue
+			}
+			if total, found := totals[coin.Denom]; found {
+				total.Add(total, amount)
+			} else {
+				totals[coin.Denom] = amount
+			}
+		}
+	}
+
+	for denom, total := range totals {
+		out.TotalFees = append(out.TotalFees, &pbcosmos.Coin{Denom: denom, Amount: total.String()})
+	}
+	sort.Slice(out.TotalFees, func(i, j int) bool { return out.TotalFees[i].Denom < out.TotalFees[j].Denom })
+
+	return out
+}
+
+func txFee(tx *pbcosmos.TxResult) *pbfctype.TxFee {
+	out := &pbfctype.TxFee{
+		Hash:  tx.Hash,
+		Index: tx.Index,
+	}
+
+	if tx.Tx != nil && tx.Tx.AuthInfo != nil && tx.Tx.AuthInfo.Fee != nil {
+		fee := tx.Tx.AuthInfo.Fee
+		out.Fee = fee.Amount
+		out.GasLimit = fee.GasLimit
+		out.Payer = fee.Payer
+		out.Granter = fee.Granter
+	}
+
+	if tx.Result == nil {
+		return out
+	}
+	out.Code = tx.Result.Code
+	out.GasWanted = tx.Result.GasWanted
+	out.GasUsed = tx.Result.GasUsed
+
+	for _, event := range tx.Result.Events {
+		switch event.EventType {
+		case feeTxEventType:
+			if out.Payer != "" {
+				continue
+			}
+			if payer, ok := eventAttribute(event, "fee_payer"); ok {
+				out.Payer = payer
+			} else if accSeq, ok := eventAttribute(event, "acc_seq"); ok {
+				// The first signer pays the fee, its acc_seq event comes first
+				if i := strings.LastIndex(accSeq, "/"); i > 0 {
+					out.Payer = accSeq[:i]
+				}
+			}
+		case feeGrantEventType:
+			if out.Granter == "" {
+				out.Granter, _ = eventAttribute(event, "granter")
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,40 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+var FeeAccountingMessageName = proto.MessageName(&pbfctransform.FeeAccounting{})
+
+func FeeAccountingFactory() *transform.Factory {
+	return &transform.Factory{
+		Obj: &pbfctransform.FeeAccounting{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			if message.MessageName() != FeeAccountingMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", FeeAccountingMessageName, message.TypeUrl)
+			}
+
+			return &FeeAccounting{}, nil
+		},
+	}
+}
+
+// FeeAccounting replaces the blocks with a FeeBlock, holding the fee, gas and payer of each transaction
+type FeeAccounting struct{}
+
+func (p *FeeAccounting) String() string {
+	return "fee accounting"
+}
+
+func (p *FeeAccounting) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
+	return FeeBlock(block), nil
+}
@@ -0,0 +1,84 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const granter = "cosmos1jv65s3grqf6v6jl3dp4t6c9t9rk99cd88lyufl"
+
+func feeTx(index uint32, fee *pbcosmos.Fee, gasWanted, gasUsed int64, code uint32, events ...*pbcosmos.Event) *pbcosmos.TxResult {
+	return &pbcosmos.TxResult{
+		Index: index,
+		Hash:  []byte{byte(index)},
+		Tx:    &pbcosmos.Tx{Body: &pbcosmos.TxBody{}, AuthInfo: &pbcosmos.AuthInfo{Fee: fee}},
+		Result: &pbcosmos.ResponseDeliverTx{
+			Code:      code,
+			GasWanted: gasWanted,
+			GasUsed:   gasUsed,
+			Events:    events,
+		},
+	}
+}
+
+func TestFeeAccounting_Transform(t *testing.T) {
+	block := &pbcosmos.Block{
+		Header: &pbcosmos.Header{Height: 400, Hash: []byte{0x07}, Time: &pbcosmos.Timestamp{Seconds: 1663000000}},
+		Transactions: []*pbcosmos.TxResult{
+			// Cosmos SDK v0.45, the payer is the first signer found in the acc_seq attribute
+			feeTx(0, &pbcosmos.Fee{Amount: []*pbcosmos.Coin{{Denom: "uatom", Amount: "2500"}}, GasLimit: 250000}, 250000, 180000, 0, anteEvents()...),
+			// Cosmos SDK v0.47, failed transaction with a fee grant
+			feeTx(1, &pbcosmos.Fee{Amount: []*pbcosmos.Coin{{Denom: "uatom", Amount: "1000"}, {Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Amount: "7"}}, GasLimit: 100000}, 100000, 100000, 11,
+				event("use_feegrant", "granter", granter, "grantee", recipient),
+				event("tx", "fee", "1000uatom", "fee_payer", recipient),
+				event("tx", "acc_seq", recipient+"/3"),
+			),
+			// Fee payer and granter set in the transaction
+			feeTx(2, &pbcosmos.Fee{Amount: []*pbcosmos.Coin{{Denom: "uatom", Amount: "99999999999999999999"}}, GasLimit: 80000, Payer: delegator, Granter: granter}, 80000, 50000, 0,
+				event("tx", "acc_seq", recipient+"/4"),
+			),
+		},
+	}
+
+	blk, err := codec.FromProto(block)
+	require.NoError(t, err)
+
+	output, err := (&FeeAccounting{}).Transform(blk, nil)
+	require.NoError(t, err)
+	fees := output.(*pbfctype.FeeBlock)
+
+	assert.Equal(t, uint64(400), fees.Height)
+	assert.Equal(t, []byte{0x07}, fees.Hash)
+	assert.Equal(t, int64(1663000000), fees.Time.Seconds)
+
+	require.Len(t, fees.Txs, 3)
+	assert.Equal(t, delegator, fees.Txs[0].Payer)
+	assert.Equal(t, "", fees.Txs[0].Granter)
+	assert.Equal(t, uint64(250000), fees.Txs[0].GasLimit)
+	assert.Equal(t, int64(180000), fees.Txs[0].GasUsed)
+
+	assert.Equal(t, recipient, fees.Txs[1].Payer)
+	assert.Equal(t, granter, fees.Txs[1].Granter)
+	assert.Equal(t, uint32(11), fees.Txs[1].Code)
+	assert.Len(t, fees.Txs[1].Fee, 2)
+
+	assert.Equal(t, delegator, fees.Txs[2].Payer)
+	assert.Equal(t, granter, fees.Txs[2].Granter)
+	assert.Equal(t, []byte{0x02}, fees.Txs[2].Hash)
+	assert.Equal(t, uint32(2), fees.Txs[2].Index)
+
+	var totals []string
+	for _, coin := range fees.TotalFees {
+		totals = append(totals, coin.Amount+coin.Denom)
+	}
+	assert.Equal(t, []string{"7ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "100000000000000003499uatom"}, totals)
+	assert.Equal(t, uint64(430000), fees.TotalGasLimit)
+	assert.Equal(t, int64(430000), fees.TotalGasWanted)
+	assert.Equal(t, int64(330000), fees.TotalGasUsed)
+}
@@ -0,0 +1,114 @@
+package transform
+
+import (
+	"strconv"
+
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// governanceRecordTypes maps the events emitted by the gov module to their record type
+var governanceRecordTypes = map[string]pbfctype.GovernanceRecordType{
+	"submit_proposal":   pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_SUBMIT_PROPOSAL,
+	"proposal_deposit":  pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_DEPOSIT,
+	"proposal_vote":     pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_VOTE,
+	"active_proposal":   pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_VOTING_PERIOD_END,
+	"inactive_proposal": pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_DEPOSIT_PERIOD_END,
+}
+
+// GovernanceRecords extracts the governance records from the events of the block
+func GovernanceRecords(block *pbcosmos.Block) *pbfctype.GovernanceBlock {
+	out := &pbfctype.GovernanceBlock{}
+	if block.Header != nil {
+		out.Height = block.Header.Height
+		out.Hash = block.Header.Hash
+		if block.Header.Time != nil {
+			out.Time = &timestamppb.Timestamp{Seconds: block.Header.Time.Seconds, Nanos: block.Header.Time.Nanos}
+		}
+	}
+
+	for _, tx := range block.Transactions {
+		if tx.Result == nil {
+			continue
+		}
+		out.Records = append(out.Records, governanceRecordsFromEvents(tx.Result.Events, tx)...)
+	}
+	if block.ResultEndBlock != nil {
+		out.Records = append(out.Records, governanceRecordsFromEvents(block.ResultEndBlock.Events, nil)...)
+	}
+
+	return out
+}
+
+// governanceRecordsFromEvents builds a record for every gov module event. Up to v0.46, the voter and depositor are
+// not part of the events, the sender of the message emitting them is used instead.
+func governanceRecordsFromEvents(events []*pbcosmos.Event, tx *pbcosmos.TxResult) (out []*pbfctype.GovernanceRecord) {
+	var msgIndexes []int
+	correlated := false
+	if tx != nil {
+		msgIndexes, correlated = EventMessageIndexes(tx.Result)
+	}
+	msgIndexOf := func(i int) int {
+		if correlated {
+			return msgIndexes[i]
+		}
+		return txLevelEvent
+	}
+
+	senders := make(map[int]string)
+	for i, event := range events {
+		if event.EventType != "message" {
+			continue
+		}
+		if _, found := senders[msgIndexOf(i)]; found {
+			continue
+		}
+		if sender, ok := eventAttribute(event, "sender"); ok {
+			senders[msgIndexOf(i)] = sender
+		}
+	}
+
+	for i, event := range events {
+		recordType, ok := governanceRecordTypes[event.EventType]
+		if !ok {
+			continue
+		}
+
+		record := governanceRecordFromEvent(recordType, event)
+		record.MsgIndex = int32(msgIndexOf(i))
+		if tx != nil {
+			record.TxHash = tx.Hash
+			record.TxIndex = tx.Index
+			if record.Address == "" {
+				record.Address = senders[msgIndexOf(i)]
+			}
+		}
+		out = append(out, record)
+	}
+	return out
+}
+
+func governanceRecordFromEvent(recordType pbfctype.GovernanceRecordType, event *pbcosmos.Event) *pbfctype.GovernanceRecord {
+	record := &pbfctype.GovernanceRecord{Type: recordType}
+	for _, attr := range event.Attributes {
+		value := string(attr.Value)
+		switch string(attr.Key) {
+		case "proposal_id":
+			record.ProposalId, _ = strconv.ParseUint(value, 10, 64)
+		case "voter", "depositor", "proposer":
+			record.Address = value
+		case "amount":
+			record.Amount = value
+		case "option":
+			record.Option = value
+		case "proposal_type":
+			record.ProposalType = value
+		case "voting_period_start":
+			record.VotingPeriodStart = true
+		case "proposal_result":
+			record.Result = value
+		}
+	}
+	return record
+}
@@ -0,0 +1,91 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+var GovernanceFilterMessageName = proto.MessageName(&pbfctransform.GovernanceFilter{})
+
+func GovernanceFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint64) *transform.Factory {
+	return &transform.Factory{
+		Obj: &pbfctransform.GovernanceFilter{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			if message.MessageName() != GovernanceFilterMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", GovernanceFilterMessageName, message.TypeUrl)
+			}
+
+			filter := &pbfctransform.GovernanceFilter{}
+			err := proto.Unmarshal(message.Value, filter)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected unmarshal error: %w", err)
+			}
+
+			proposalMap := make(map[uint64]bool)
+			for _, proposalID := range filter.ProposalIds {
+				proposalMap[proposalID] = true
+			}
+
+			return &GovernanceFilter{
+				ProposalIDs:        proposalMap,
+				possibleIndexSizes: possibleIndexSizes,
+				indexStore:         indexStore,
+			}, nil
+		},
+	}
+}
+
+// GovernanceFilter outputs the governance records of the block for the proposals in ProposalIDs, all of them when empty
+type GovernanceFilter struct {
+	ProposalIDs map[uint64]bool
+
+	indexStore         dstore.Store
+	possibleIndexSizes []uint64
+}
+
+func (p *GovernanceFilter) String() string {
+	return fmt.Sprintf("proposal ids: %v", p.ProposalIDs)
+}
+
+func (p *GovernanceFilter) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
+
+	out := GovernanceRecords(block)
+	if len(p.ProposalIDs) == 0 {
+		return out, nil
+	}
+
+	records := out.Records
+	out.Records = nil
+	for _, record := range records {
+		if p.ProposalIDs[record.ProposalId] {
+			out.Records = append(out.Records, record)
+		}
+	}
+
+	return out, nil
+}
+
+func (p *GovernanceFilter) GetIndexProvider() bstream.BlockIndexProvider {
+	if p.indexStore == nil {
+		return nil
+	}
+
+	if len(p.ProposalIDs) == 0 {
+		return nil
+	}
+
+	return NewGovernanceProposalIndexProvider(
+		p.indexStore,
+		p.possibleIndexSizes,
+		p.ProposalIDs,
+	)
+}
@@ -0,0 +1,137 @@
+package transform
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const msgSubmitProposal = "/cosmos.gov.v1.MsgSubmitProposal"
+
+// governanceBlock holds Cosmos SDK v0.47 transactions voting on proposal 782 and submitting proposal 790, the end
+// blocker closing the voting period of proposal 782 and the deposit period of proposal 781
+func governanceBlock() *pbcosmos.Block {
+	voteTx := sendAndVoteTx()
+	voteTx.Index = 0
+	voteTx.Hash = []byte{0xaa}
+
+	submitTx := txResult([]string{msgSubmitProposal}, &pbcosmos.ResponseDeliverTx{Events: append(anteEvents(), withMsgIndex("0",
+		event("message", "action", msgSubmitProposal, "sender", recipient, "module", "governance"),
+		event("coin_spent", "spender", recipient, "amount", "10000000uatom"),
+		event("proposal_deposit", "amount", "10000000uatom", "proposal_id", "790"),
+		event("submit_proposal", "proposal_id", "790", "proposal_messages", ",/cosmos.gov.v1.MsgExecLegacyContent", "voting_period_start", "790"),
+	)...)})
+	submitTx.Index = 1
+	submitTx.Hash = []byte{0xbb}
+
+	return &pbcosmos.Block{
+		Header: &pbcosmos.Header{Height: 12000000, Hash: []byte{0x08}, Time: &pbcosmos.Timestamp{Seconds: 1663000000}},
+		ResultEndBlock: &pbcosmos.ResponseEndBlock{Events: []*pbcosmos.Event{
+			event("inactive_proposal", "proposal_id", "781", "proposal_result", "proposal_dropped"),
+			event("active_proposal", "proposal_id", "782", "proposal_result", "proposal_passed"),
+		}},
+		Transactions: []*pbcosmos.TxResult{voteTx, submitTx},
+	}
+}
+
+func TestGovernanceRecords(t *testing.T) {
+	records := GovernanceRecords(governanceBlock()).Records
+	require.Len(t, records, 5)
+
+	vote := records[0]
+	assert.Equal(t, pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_VOTE, vote.Type)
+	assert.Equal(t, uint64(782), vote.ProposalId)
+	assert.Equal(t, delegator, vote.Address)
+	assert.Equal(t, `{"option":1,"weight":"1.000000000000000000"}`, vote.Option)
+	assert.Equal(t, []byte{0xaa}, vote.TxHash)
+	assert.Equal(t, int32(1), vote.MsgIndex)
+
+	deposit := records[1]
+	assert.Equal(t, pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_DEPOSIT, deposit.Type)
+	assert.Equal(t, uint64(790), deposit.ProposalId)
+	assert.Equal(t, recipient, deposit.Address)
+	assert.Equal(t, "10000000uatom", deposit.Amount)
+
+	submit := records[2]
+	assert.Equal(t, pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_SUBMIT_PROPOSAL, submit.Type)
+	assert.Equal(t, uint64(790), submit.ProposalId)
+	assert.Equal(t, recipient, submit.Address)
+	assert.True(t, submit.VotingPeriodStart)
+	assert.Equal(t, uint32(1), submit.TxIndex)
+
+	dropped := records[3]
+	assert.Equal(t, pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_DEPOSIT_PERIOD_END, dropped.Type)
+	assert.Equal(t, uint64(781), dropped.ProposalId)
+	assert.Equal(t, "proposal_dropped", dropped.Result)
+	assert.Equal(t, int32(-1), dropped.MsgIndex)
+	assert.Nil(t, dropped.TxHash)
+
+	passed := records[4]
+	assert.Equal(t, pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_VOTING_PERIOD_END, passed.Type)
+	assert.Equal(t, uint64(782), passed.ProposalId)
+	assert.Equal(t, "proposal_passed", passed.Result)
+}
+
+func TestGovernanceFilter_Transform(t *testing.T) {
+	tests := []struct {
+		name          string
+		proposalIDs   []uint64
+		expectedTypes []pbfctype.GovernanceRecordType
+	}{
+		{
+			name:        "vote and end of voting period",
+			proposalIDs: []uint64{782},
+			expectedTypes: []pbfctype.GovernanceRecordType{
+				pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_VOTE,
+				pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_VOTING_PERIOD_END,
+			},
+		},
+		{
+			name:        "submission",
+			proposalIDs: []uint64{790, 1},
+			expectedTypes: []pbfctype.GovernanceRecordType{
+				pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_DEPOSIT,
+				pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_SUBMIT_PROPOSAL,
+			},
+		},
+		{
+			name:        "unknown proposal",
+			proposalIDs: []uint64{1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blk, err := codec.FromProto(governanceBlock())
+			require.NoError(t, err)
+
+			filter := &GovernanceFilter{ProposalIDs: make(map[uint64]bool)}
+			for _, proposalID := range test.proposalIDs {
+				filter.ProposalIDs[proposalID] = true
+			}
+
+			output, err := filter.Transform(blk, nil)
+			require.NoError(t, err)
+
+			var types []pbfctype.GovernanceRecordType
+			for _, record := range output.(*pbfctype.GovernanceBlock).Records {
+				types = append(types, record.Type)
+			}
+			assert.Equal(t, test.expectedTypes, types)
+		})
+	}
+}
+
+func TestGovernanceProposalIndexer(t *testing.T) {
+	keys, err := IndexKeys(GovernanceProposalIndexShortName, governanceBlock())
+	require.NoError(t, err)
+
+	sort.Strings(keys)
+	assert.Equal(t, []string{"781", "782", "790"}, keys)
+}
@@ -0,0 +1,40 @@
+package transform
+
+import (
+	"strconv"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+const GovernanceProposalIndexShortName = "govproposal"
+
+func NewGovernanceProposalIndexProvider(
+	store dstore.Store,
+	possibleIndexSizes []uint64,
+	proposalIDs map[uint64]bool,
+) *transform.GenericBlockIndexProvider {
+	return transform.NewGenericBlockIndexProvider(
+		store,
+		GovernanceProposalIndexShortName,
+		possibleIndexSizes,
+		getGovernanceProposalFilterFunc(proposalIDs),
+	)
+}
+
+func getGovernanceProposalFilterFunc(proposalIDs map[uint64]bool) func(transform.BitmapGetter) []uint64 {
+	return func(bitmaps transform.BitmapGetter) (matchingBlocks []uint64) {
+		out := roaring64.NewBitmap()
+		for proposalID := range proposalIDs {
+			if bm := bitmaps.Get(governanceProposalIndexKey(proposalID)); bm != nil {
+				out.Or(bm)
+			}
+		}
+		return nilIfEmpty(out.ToArray())
+	}
+}
+
+func governanceProposalIndexKey(proposalID uint64) string {
+	return strconv.FormatUint(proposalID, 10)
+}
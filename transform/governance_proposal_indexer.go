@@ -0,0 +1,45 @@
+package transform
+
+import (
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+func init() {
+	RegisterIndexer(GovernanceProposalIndexShortName, func(blockIndexer BlockIndexer) Indexer {
+		return &GovernanceProposalIndexer{BlockIndexer: blockIndexer}
+	})
+}
+
+// GovernanceProposalIndexer indexes the IDs of the proposals having governance records in each block
+type GovernanceProposalIndexer struct {
+	BlockIndexer BlockIndexer
+}
+
+func NewGovernanceProposalIndexer(indexStore dstore.Store, indexSize uint64, startBlock uint64) *GovernanceProposalIndexer {
+	bi := transform.NewBlockIndexer(
+		indexStore,
+		indexSize,
+		GovernanceProposalIndexShortName,
+		transform.WithDefinedStartBlock(startBlock),
+	)
+
+	return &GovernanceProposalIndexer{
+		BlockIndexer: bi,
+	}
+}
+
+func (i *GovernanceProposalIndexer) ProcessBlock(block *pbcosmos.Block) {
+	keyMap := make(map[string]bool)
+	for _, record := range GovernanceRecords(block).Records {
+		keyMap[governanceProposalIndexKey(record.ProposalId)] = true
+	}
+
+	var keys []string
+	for key := range keyMap {
+		keys = append(keys, key)
+	}
+
+	i.BlockIndexer.Add(keys, block.Header.Height)
+}
@@ -0,0 +1,102 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+var HeaderOnlyMessageName = proto.MessageName(&pbfctransform.HeaderOnly{})
+
+func HeaderOnlyFactory() *transform.Factory {
+	return &transform.Factory{
+		Obj: &pbfctransform.HeaderOnly{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			if message.MessageName() != HeaderOnlyMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", HeaderOnlyMessageName, message.TypeUrl)
+			}
+
+			return &HeaderOnly{}, nil
+		},
+	}
+}
+
+// HeaderOnly replaces the blocks with a LightBlock, dropping the transactions, events and evidence
+type HeaderOnly struct{}
+
+func (p *HeaderOnly) String() string {
+	return "header only"
+}
+
+func (p *HeaderOnly) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
+	return LightBlock(block), nil
+}
+
+// LightBlock summarizes the content of block
+func LightBlock(block *pbcosmos.Block) *pbfctype.LightBlock {
+	out := &pbfctype.LightBlock{
+		Header:               block.Header,
+		LastCommit:           commitSummary(block.LastCommit),
+		TxCount:              uint32(len(block.Transactions)),
+		ValidatorUpdateCount: uint32(len(block.ValidatorUpdates)),
+	}
+
+	if block.Evidence != nil {
+		out.EvidenceCount = uint32(len(block.Evidence.Evidence))
+	}
+	if block.ResultBeginBlock != nil {
+		out.EventCount += uint32(len(block.ResultBeginBlock.Events))
+	}
+	if block.ResultEndBlock != nil {
+		out.EventCount += uint32(len(block.ResultEndBlock.Events))
+	}
+
+	for _, tx := range block.Transactions {
+		if tx.Result == nil {
+			continue
+		}
+		if tx.Result.Code != 0 {
+			out.FailedTxCount++
+		}
+		out.EventCount += uint32(len(tx.Result.Events))
+		out.GasWanted += tx.Result.GasWanted
+		out.GasUsed += tx.Result.GasUsed
+	}
+
+	return out
+}
+
+func commitSummary(commit *pbcosmos.Commit) *pbfctype.CommitSummary {
+	if commit == nil {
+		return nil
+	}
+
+	out := &pbfctype.CommitSummary{
+		Height:         commit.Height,
+		Round:          commit.Round,
+		SignatureCount: uint32(len(commit.Signatures)),
+	}
+	if commit.BlockId != nil {
+		out.BlockHash = commit.BlockId.Hash
+	}
+
+	for _, sig := range commit.Signatures {
+		switch sig.BlockIdFlag {
+		case pbcosmos.BlockIDFlag_BLOCK_ID_FLAG_COMMIT:
+			out.CommitCount++
+		case pbcosmos.BlockIDFlag_BLOCK_ID_FLAG_NIL:
+			out.NilCount++
+		case pbcosmos.BlockIDFlag_BLOCK_ID_FLAG_ABSENT:
+			out.AbsentCount++
+		}
+	}
+	return out
+}
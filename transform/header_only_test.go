@@ -0,0 +1,61 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderOnly_Transform(t *testing.T) {
+	block := &pbcosmos.Block{
+		Header: &pbcosmos.Header{Height: 200, ChainId: "cosmoshub-4", Hash: []byte{0x05}, Time: &pbcosmos.Timestamp{Seconds: 1663000000}},
+		LastCommit: &pbcosmos.Commit{
+			Height:  199,
+			Round:   1,
+			BlockId: &pbcosmos.BlockID{Hash: []byte{0x06}},
+			Signatures: []*pbcosmos.CommitSig{
+				{BlockIdFlag: pbcosmos.BlockIDFlag_BLOCK_ID_FLAG_COMMIT},
+				{BlockIdFlag: pbcosmos.BlockIDFlag_BLOCK_ID_FLAG_COMMIT},
+				{BlockIdFlag: pbcosmos.BlockIDFlag_BLOCK_ID_FLAG_NIL},
+				{BlockIdFlag: pbcosmos.BlockIDFlag_BLOCK_ID_FLAG_ABSENT},
+			},
+		},
+		Evidence:         &pbcosmos.EvidenceList{Evidence: []*pbcosmos.Evidence{{}}},
+		ResultBeginBlock: &pbcosmos.ResponseBeginBlock{Events: []*pbcosmos.Event{event("mint", "amount", "100uatom")}},
+		ResultEndBlock:   &pbcosmos.ResponseEndBlock{},
+		Transactions: []*pbcosmos.TxResult{
+			{Index: 0, Tx: &pbcosmos.Tx{Body: &pbcosmos.TxBody{}}, Result: &pbcosmos.ResponseDeliverTx{GasWanted: 200000, GasUsed: 150000, Events: anteEvents()}},
+			{Index: 1, Tx: &pbcosmos.Tx{Body: &pbcosmos.TxBody{}}, Result: &pbcosmos.ResponseDeliverTx{Code: 5, GasWanted: 100000, GasUsed: 50000}},
+		},
+	}
+
+	blk, err := codec.FromProto(block)
+	require.NoError(t, err)
+
+	output, err := (&HeaderOnly{}).Transform(blk, nil)
+	require.NoError(t, err)
+	light := output.(*pbfctype.LightBlock)
+
+	assert.Equal(t, uint64(200), light.Header.Height)
+	assert.Equal(t, "cosmoshub-4", light.Header.ChainId)
+	assert.Equal(t, uint32(2), light.TxCount)
+	assert.Equal(t, uint32(1), light.FailedTxCount)
+	assert.Equal(t, uint32(1+len(anteEvents())), light.EventCount)
+	assert.Equal(t, uint32(1), light.EvidenceCount)
+	assert.Equal(t, int64(300000), light.GasWanted)
+	assert.Equal(t, int64(200000), light.GasUsed)
+
+	require.NotNil(t, light.LastCommit)
+	assert.Equal(t, int64(199), light.LastCommit.Height)
+	assert.Equal(t, int32(1), light.LastCommit.Round)
+	assert.Equal(t, []byte{0x06}, light.LastCommit.BlockHash)
+	assert.Equal(t, uint32(4), light.LastCommit.SignatureCount)
+	assert.Equal(t, uint32(2), light.LastCommit.CommitCount)
+	assert.Equal(t, uint32(1), light.LastCommit.NilCount)
+	assert.Equal(t, uint32(1), light.LastCommit.AbsentCount)
+}
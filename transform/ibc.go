@@ -0,0 +1,191 @@
+package transform
+
+import (
+	"encoding/json"
+	"strconv"
+
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ibcPacketStages maps the events emitted by ibc-go core on each step of a packet lifecycle to their stage
+var ibcPacketStages = map[string]pbfctype.IbcPacketStage{
+	"send_packet":           pbfctype.IbcPacketStage_IBC_PACKET_STAGE_SEND,
+	"recv_packet":           pbfctype.IbcPacketStage_IBC_PACKET_STAGE_RECV,
+	"write_acknowledgement": pbfctype.IbcPacketStage_IBC_PACKET_STAGE_WRITE_ACKNOWLEDGEMENT,
+	"acknowledge_packet":    pbfctype.IbcPacketStage_IBC_PACKET_STAGE_ACKNOWLEDGE,
+	"timeout_packet":        pbfctype.IbcPacketStage_IBC_PACKET_STAGE_TIMEOUT,
+}
+
+const (
+	ibcTransferPacketEventType  = "fungible_token_packet"
+	ibcTransferTimeoutEventType = "timeout"
+)
+
+// fungibleTokenPacketData is the packet data of ICS-20 transfers
+type fungibleTokenPacketData struct {
+	Denom    string `json:"denom"`
+	Amount   string `json:"amount"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Memo     string `json:"memo"`
+}
+
+// IbcPackets extracts the IBC packets records from the events of the block
+func IbcPackets(block *pbcosmos.Block) *pbfctype.IbcPacketBlock {
+	out := &pbfctype.IbcPacketBlock{}
+	if block.Header != nil {
+		out.Height = block.Header.Height
+		out.Hash = block.Header.Hash
+		if block.Header.Time != nil {
+			out.Time = &timestamppb.Timestamp{Seconds: block.Header.Time.Seconds, Nanos: block.Header.Time.Nanos}
+		}
+	}
+
+	if block.ResultBeginBlock != nil {
+		out.Packets = append(out.Packets, ibcPacketsFromEvents(block.ResultBeginBlock.Events, nil)...)
+	}
+	for _, tx := range block.Transactions {
+		if tx.Result == nil {
+			continue
+		}
+		out.Packets = append(out.Packets, ibcPacketsFromEvents(tx.Result.Events, tx)...)
+	}
+	if block.ResultEndBlock != nil {
+		out.Packets = append(out.Packets, ibcPacketsFromEvents(block.ResultEndBlock.Events, nil)...)
+	}
+
+	return out
+}
+
+// ibcPacketsFromEvents builds a record for every packet event. The transfer details of acknowledge and timeout stages
+// are not part of the packet events, they are taken from the events of the transfer module emitted by the same message,
+// in the same order.
+func ibcPacketsFromEvents(events []*pbcosmos.Event, tx *pbcosmos.TxResult) (out []*pbfctype.IbcPacket) {
+	var msgIndexes []int
+	correlated := false
+	if tx != nil {
+		msgIndexes, correlated = EventMessageIndexes(tx.Result)
+	}
+	msgIndexOf := func(i int) int {
+		if correlated {
+			return msgIndexes[i]
+		}
+		return txLevelEvent
+	}
+
+	transferEvents := make(map[int][]*pbcosmos.Event)
+	timeoutEvents := make(map[int][]*pbcosmos.Event)
+	for i, event := range events {
+		switch event.EventType {
+		case ibcTransferPacketEventType:
+			if _, ok := eventAttribute(event, "denom"); ok {
+				transferEvents[msgIndexOf(i)] = append(transferEvents[msgIndexOf(i)], event)
+			}
+		case ibcTransferTimeoutEventType:
+			if _, ok := eventAttribute(event, "refund_denom"); ok {
+				timeoutEvents[msgIndexOf(i)] = append(timeoutEvents[msgIndexOf(i)], event)
+			}
+		}
+	}
+
+	for i, event := range events {
+		stage, ok := ibcPacketStages[event.EventType]
+		if !ok {
+			continue
+		}
+
+		packet := ibcPacketFromEvent(stage, event)
+		packet.MsgIndex = int32(msgIndexOf(i))
+		if tx != nil {
+			packet.TxHash = tx.Hash
+			packet.TxIndex = tx.Index
+		}
+
+		switch stage {
+		case pbfctype.IbcPacketStage_IBC_PACKET_STAGE_ACKNOWLEDGE:
+			if pending := transferEvents[msgIndexOf(i)]; len(pending) > 0 {
+				packet.Sender, _ = eventAttribute(pending[0], "sender")
+				packet.Receiver, _ = eventAttribute(pending[0], "receiver")
+				packet.Denom, _ = eventAttribute(pending[0], "denom")
+				packet.Amount, _ = eventAttribute(pending[0], "amount")
+				packet.Memo, _ = eventAttribute(pending[0], "memo")
+				if ack, ok := eventAttribute(pending[0], "acknowledgement"); ok && packet.Acknowledgement == "" {
+					packet.Acknowledgement = ack
+				}
+				transferEvents[msgIndexOf(i)] = pending[1:]
+			}
+		case pbfctype.IbcPacketStage_IBC_PACKET_STAGE_TIMEOUT:
+			if pending := timeoutEvents[msgIndexOf(i)]; len(pending) > 0 {
+				packet.Sender, _ = eventAttribute(pending[0], "refund_receiver")
+				packet.Denom, _ = eventAttribute(pending[0], "refund_denom")
+				packet.Amount, _ = eventAttribute(pending[0], "refund_amount")
+				packet.Memo, _ = eventAttribute(pending[0], "memo")
+				timeoutEvents[msgIndexOf(i)] = pending[1:]
+			}
+		}
+
+		out = append(out, packet)
+	}
+	return out
+}
+
+func ibcPacketFromEvent(stage pbfctype.IbcPacketStage, event *pbcosmos.Event) *pbfctype.IbcPacket {
+	packet := &pbfctype.IbcPacket{Stage: stage}
+	for _, attr := range event.Attributes {
+		value := string(attr.Value)
+		switch string(attr.Key) {
+		case "packet_sequence":
+			packet.Sequence, _ = strconv.ParseUint(value, 10, 64)
+		case "packet_src_port":
+			packet.SourcePort = value
+		case "packet_src_channel":
+			packet.SourceChannel = value
+		case "packet_dst_port":
+			packet.DestinationPort = value
+		case "packet_dst_channel":
+			packet.DestinationChannel = value
+		case "connection_id", "packet_connection":
+			packet.Connection = value
+		case "packet_data":
+			packet.Data = value
+		case "packet_timeout_height":
+			packet.TimeoutHeight = value
+		case "packet_timeout_timestamp":
+			packet.TimeoutTimestamp, _ = strconv.ParseUint(value, 10, 64)
+		case "packet_ack":
+			packet.Acknowledgement = value
+		}
+	}
+
+	if packet.Data != "" {
+		var data fungibleTokenPacketData
+		if err := json.Unmarshal([]byte(packet.Data), &data); err == nil {
+			packet.Denom = data.Denom
+			packet.Amount = data.Amount
+			packet.Sender = data.Sender
+			packet.Receiver = data.Receiver
+			packet.Memo = data.Memo
+		}
+	}
+	return packet
+}
+
+// ibcLocalEnd returns the port and channel of the packet on the chain emitting the event
+func ibcLocalEnd(packet *pbfctype.IbcPacket) (port string, channel string) {
+	switch packet.Stage {
+	case pbfctype.IbcPacketStage_IBC_PACKET_STAGE_RECV, pbfctype.IbcPacketStage_IBC_PACKET_STAGE_WRITE_ACKNOWLEDGEMENT:
+		return packet.DestinationPort, packet.DestinationChannel
+	}
+	return packet.SourcePort, packet.SourceChannel
+}
+
+func eventAttribute(event *pbcosmos.Event, key string) (string, bool) {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key {
+			return string(attr.Value), true
+		}
+	}
+	return "", false
+}
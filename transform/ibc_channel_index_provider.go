@@ -0,0 +1,45 @@
+package transform
+
+import (
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+// IbcChannelIndexShortName is the index of the ports and channels of the chain's end of IBC packets, with `{port}/{channel}` keys
+const IbcChannelIndexShortName = "ibcchannel"
+
+func NewIbcChannelIndexProvider(
+	store dstore.Store,
+	possibleIndexSizes []uint64,
+	channels map[string]bool,
+	ports map[string]bool,
+) *transform.GenericBlockIndexProvider {
+	return transform.NewGenericBlockIndexProvider(
+		store,
+		IbcChannelIndexShortName,
+		possibleIndexSizes,
+		getIbcChannelFilterFunc(channels, ports),
+	)
+}
+
+func getIbcChannelFilterFunc(channels map[string]bool, ports map[string]bool) func(transform.BitmapGetter) []uint64 {
+	return func(bitmaps transform.BitmapGetter) (matchingBlocks []uint64) {
+		out := roaring64.NewBitmap()
+		for channel := range channels {
+			if bm := bitmaps.GetByPrefixAndSuffix("", "/"+channel); bm != nil {
+				out.Or(bm)
+			}
+		}
+		for port := range ports {
+			if bm := bitmaps.GetByPrefixAndSuffix(port+"/", ""); bm != nil {
+				out.Or(bm)
+			}
+		}
+		return nilIfEmpty(out.ToArray())
+	}
+}
+
+func ibcChannelIndexKey(port, channel string) string {
+	return port + "/" + channel
+}
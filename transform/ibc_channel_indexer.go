@@ -0,0 +1,46 @@
+package transform
+
+import (
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+func init() {
+	RegisterIndexer(IbcChannelIndexShortName, func(blockIndexer BlockIndexer) Indexer {
+		return &IbcChannelIndexer{BlockIndexer: blockIndexer}
+	})
+}
+
+// IbcChannelIndexer indexes the port and channel of the chain's end of the IBC packets found in each block
+type IbcChannelIndexer struct {
+	BlockIndexer BlockIndexer
+}
+
+func NewIbcChannelIndexer(indexStore dstore.Store, indexSize uint64, startBlock uint64) *IbcChannelIndexer {
+	bi := transform.NewBlockIndexer(
+		indexStore,
+		indexSize,
+		IbcChannelIndexShortName,
+		transform.WithDefinedStartBlock(startBlock),
+	)
+
+	return &IbcChannelIndexer{
+		BlockIndexer: bi,
+	}
+}
+
+func (i *IbcChannelIndexer) ProcessBlock(block *pbcosmos.Block) {
+	keyMap := make(map[string]bool)
+
+	for _, packet := range IbcPackets(block).Packets {
+		keyMap[ibcChannelIndexKey(ibcLocalEnd(packet))] = true
+	}
+
+	var keys []string
+	for key := range keyMap {
+		keys = append(keys, key)
+	}
+
+	i.BlockIndexer.Add(keys, block.Header.Height)
+}
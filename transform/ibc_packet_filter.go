@@ -0,0 +1,101 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+var IbcPacketFilterMessageName = proto.MessageName(&pbfctransform.IbcPacketFilter{})
+
+func IbcPacketFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint64) *transform.Factory {
+	return &transform.Factory{
+		Obj: &pbfctransform.IbcPacketFilter{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			if message.MessageName() != IbcPacketFilterMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", IbcPacketFilterMessageName, message.TypeUrl)
+			}
+
+			filter := &pbfctransform.IbcPacketFilter{}
+			err := proto.Unmarshal(message.Value, filter)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected unmarshal error: %w", err)
+			}
+
+			channelMap := make(map[string]bool)
+			for _, channel := range filter.Channels {
+				channelMap[channel] = true
+			}
+
+			portMap := make(map[string]bool)
+			for _, port := range filter.Ports {
+				portMap[port] = true
+			}
+
+			return &IbcPacketFilter{
+				Channels:           channelMap,
+				Ports:              portMap,
+				possibleIndexSizes: possibleIndexSizes,
+				indexStore:         indexStore,
+			}, nil
+		},
+	}
+}
+
+// IbcPacketFilter outputs the IBC packets records of the block for which the chain's end is one of Channels or one of
+// Ports, all of them when both are empty
+type IbcPacketFilter struct {
+	Channels map[string]bool
+	Ports    map[string]bool
+
+	indexStore         dstore.Store
+	possibleIndexSizes []uint64
+}
+
+func (p *IbcPacketFilter) String() string {
+	return fmt.Sprintf("channels: %v, ports: %v", p.Channels, p.Ports)
+}
+
+func (p *IbcPacketFilter) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
+
+	out := IbcPackets(block)
+	if len(p.Channels) == 0 && len(p.Ports) == 0 {
+		return out, nil
+	}
+
+	packets := out.Packets
+	out.Packets = nil
+	for _, packet := range packets {
+		port, channel := ibcLocalEnd(packet)
+		if p.Channels[channel] || p.Ports[port] {
+			out.Packets = append(out.Packets, packet)
+		}
+	}
+
+	return out, nil
+}
+
+func (p *IbcPacketFilter) GetIndexProvider() bstream.BlockIndexProvider {
+	if p.indexStore == nil {
+		return nil
+	}
+
+	if len(p.Channels) == 0 && len(p.Ports) == 0 {
+		return nil
+	}
+
+	return NewIbcChannelIndexProvider(
+		p.indexStore,
+		p.possibleIndexSizes,
+		p.Channels,
+		p.Ports,
+	)
+}
@@ -0,0 +1,218 @@
+package transform
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	osmosisReceiver = "osmo1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqe6y7hd"
+	ibcDenom        = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	transferData    = `{"amount":"1000000","denom":"uatom","receiver":"` + osmosisReceiver + `","sender":"` + delegator + `"}`
+	recvData        = `{"amount":"25","denom":"uosmo","receiver":"` + delegator + `","sender":"` + osmosisReceiver + `"}`
+)
+
+func packetEvent(eventType string, sequence, srcChannel, dstChannel string, extra ...string) *pbcosmos.Event {
+	keyValues := []string{
+		"packet_timeout_height", "1-6543210",
+		"packet_timeout_timestamp", "1663000600000000000",
+		"packet_sequence", sequence,
+		"packet_src_port", "transfer",
+		"packet_src_channel", srcChannel,
+		"packet_dst_port", "transfer",
+		"packet_dst_channel", dstChannel,
+		"packet_channel_ordering", "ORDER_UNORDERED",
+		"packet_connection", "connection-257",
+	}
+	return event(eventType, append(keyValues, extra...)...)
+}
+
+// ibcBlock holds Cosmos SDK v0.47 transactions relaying and sending IBC transfers on the cosmos hub, which channel-141
+// is connected to the channel-0 of osmosis
+func ibcBlock() *pbcosmos.Block {
+	sendTx := &pbcosmos.TxResult{
+		Index: 0,
+		Hash:  []byte{0xaa},
+		Tx:    &pbcosmos.Tx{Body: &pbcosmos.TxBody{}},
+		Result: &pbcosmos.ResponseDeliverTx{Events: append(anteEvents(), withMsgIndex("0",
+			event("message", "action", "/ibc.applications.transfer.v1.MsgTransfer", "sender", delegator),
+			packetEvent("send_packet", "812345", "channel-141", "channel-0", "packet_data", transferData),
+			event("ibc_transfer", "sender", delegator, "receiver", osmosisReceiver),
+		)...)},
+	}
+
+	relayTx := &pbcosmos.TxResult{
+		Index: 1,
+		Hash:  []byte{0xbb},
+		Tx:    &pbcosmos.Tx{Body: &pbcosmos.TxBody{}},
+		Result: &pbcosmos.ResponseDeliverTx{Events: append(append(append(anteEvents(),
+			withMsgIndex("0",
+				event("update_client", "client_id", "07-tendermint-259", "consensus_height", "1-6543100"),
+			)...),
+			withMsgIndex("1",
+				packetEvent("recv_packet", "91011", "channel-0", "channel-141", "packet_data", recvData),
+				event("fungible_token_packet", "module", "transfer", "sender", osmosisReceiver, "receiver", delegator, "denom", "uosmo", "amount", "25", "success", "true"),
+				packetEvent("write_acknowledgement", "91011", "channel-0", "channel-141", "packet_data", recvData, "packet_ack", `{"result":"AQ=="}`),
+			)...),
+			withMsgIndex("2",
+				packetEvent("acknowledge_packet", "812300", "channel-141", "channel-0"),
+				event("fungible_token_packet", "module", "transfer", "sender", delegator, "receiver", osmosisReceiver, "denom", ibcDenom, "amount", "42", "memo", "", "acknowledgement", `result:"\001"`),
+				event("fungible_token_packet", "success", "\001"),
+			)...,
+		)},
+	}
+
+	timeoutTx := &pbcosmos.TxResult{
+		Index: 2,
+		Hash:  []byte{0xcc},
+		Tx:    &pbcosmos.Tx{Body: &pbcosmos.TxBody{}},
+		Result: &pbcosmos.ResponseDeliverTx{Events: append(anteEvents(), withMsgIndex("0",
+			event("timeout", "module", "transfer", "refund_receiver", delegator, "refund_denom", "uatom", "refund_amount", "500", "memo", ""),
+			packetEvent("timeout_packet", "812200", "channel-141", "channel-0"),
+		)...)},
+	}
+
+	return &pbcosmos.Block{
+		Header:       &pbcosmos.Header{Height: 12000001, Hash: []byte{0x03}, Time: &pbcosmos.Timestamp{Seconds: 1663000000}},
+		Transactions: []*pbcosmos.TxResult{sendTx, relayTx, timeoutTx},
+	}
+}
+
+func TestIbcPackets(t *testing.T) {
+	packets := IbcPackets(ibcBlock())
+
+	assert.Equal(t, uint64(12000001), packets.Height)
+	require.Len(t, packets.Packets, 5)
+
+	send := packets.Packets[0]
+	assert.Equal(t, pbfctype.IbcPacketStage_IBC_PACKET_STAGE_SEND, send.Stage)
+	assert.Equal(t, uint64(812345), send.Sequence)
+	assert.Equal(t, "channel-141", send.SourceChannel)
+	assert.Equal(t, "channel-0", send.DestinationChannel)
+	assert.Equal(t, "connection-257", send.Connection)
+	assert.Equal(t, "uatom", send.Denom)
+	assert.Equal(t, "1000000", send.Amount)
+	assert.Equal(t, delegator, send.Sender)
+	assert.Equal(t, osmosisReceiver, send.Receiver)
+	assert.Equal(t, "1-6543210", send.TimeoutHeight)
+	assert.Equal(t, uint64(1663000600000000000), send.TimeoutTimestamp)
+	assert.Equal(t, []byte{0xaa}, send.TxHash)
+	assert.Equal(t, int32(0), send.MsgIndex)
+
+	recv := packets.Packets[1]
+	assert.Equal(t, pbfctype.IbcPacketStage_IBC_PACKET_STAGE_RECV, recv.Stage)
+	assert.Equal(t, "uosmo", recv.Denom)
+	assert.Equal(t, delegator, recv.Receiver)
+	assert.Equal(t, int32(1), recv.MsgIndex)
+
+	writeAck := packets.Packets[2]
+	assert.Equal(t, pbfctype.IbcPacketStage_IBC_PACKET_STAGE_WRITE_ACKNOWLEDGEMENT, writeAck.Stage)
+	assert.Equal(t, `{"result":"AQ=="}`, writeAck.Acknowledgement)
+	assert.Equal(t, "uosmo", writeAck.Denom)
+
+	ack := packets.Packets[3]
+	assert.Equal(t, pbfctype.IbcPacketStage_IBC_PACKET_STAGE_ACKNOWLEDGE, ack.Stage)
+	assert.Equal(t, uint64(812300), ack.Sequence)
+	assert.Equal(t, ibcDenom, ack.Denom)
+	assert.Equal(t, "42", ack.Amount)
+	assert.Equal(t, delegator, ack.Sender)
+	assert.Equal(t, osmosisReceiver, ack.Receiver)
+	assert.Equal(t, `result:"\001"`, ack.Acknowledgement)
+	assert.Equal(t, int32(2), ack.MsgIndex)
+
+	timeout := packets.Packets[4]
+	assert.Equal(t, pbfctype.IbcPacketStage_IBC_PACKET_STAGE_TIMEOUT, timeout.Stage)
+	assert.Equal(t, uint64(812200), timeout.Sequence)
+	assert.Equal(t, "uatom", timeout.Denom)
+	assert.Equal(t, "500", timeout.Amount)
+	assert.Equal(t, delegator, timeout.Sender)
+	assert.Equal(t, []byte{0xcc}, timeout.TxHash)
+}
+
+func TestIbcPacketFilter_Transform(t *testing.T) {
+	tests := []struct {
+		name           string
+		channels       []string
+		ports          []string
+		expectedStages []pbfctype.IbcPacketStage
+	}{
+		{
+			name:     "local channel",
+			channels: []string{"channel-141"},
+			expectedStages: []pbfctype.IbcPacketStage{
+				pbfctype.IbcPacketStage_IBC_PACKET_STAGE_SEND,
+				pbfctype.IbcPacketStage_IBC_PACKET_STAGE_RECV,
+				pbfctype.IbcPacketStage_IBC_PACKET_STAGE_WRITE_ACKNOWLEDGEMENT,
+				pbfctype.IbcPacketStage_IBC_PACKET_STAGE_ACKNOWLEDGE,
+				pbfctype.IbcPacketStage_IBC_PACKET_STAGE_TIMEOUT,
+			},
+		},
+		{
+			name:     "counterparty channel",
+			channels: []string{"channel-0"},
+		},
+		{
+			name:  "port",
+			ports: []string{"transfer"},
+			expectedStages: []pbfctype.IbcPacketStage{
+				pbfctype.IbcPacketStage_IBC_PACKET_STAGE_SEND,
+				pbfctype.IbcPacketStage_IBC_PACKET_STAGE_RECV,
+				pbfctype.IbcPacketStage_IBC_PACKET_STAGE_WRITE_ACKNOWLEDGEMENT,
+				pbfctype.IbcPacketStage_IBC_PACKET_STAGE_ACKNOWLEDGE,
+				pbfctype.IbcPacketStage_IBC_PACKET_STAGE_TIMEOUT,
+			},
+		},
+		{
+			name:  "other port",
+			ports: []string{"icahost"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blk, err := codec.FromProto(ibcBlock())
+			require.NoError(t, err)
+
+			filter := &IbcPacketFilter{Channels: make(map[string]bool), Ports: make(map[string]bool)}
+			for _, channel := range test.channels {
+				filter.Channels[channel] = true
+			}
+			for _, port := range test.ports {
+				filter.Ports[port] = true
+			}
+
+			output, err := filter.Transform(blk, nil)
+			require.NoError(t, err)
+
+			var stages []pbfctype.IbcPacketStage
+			for _, packet := range output.(*pbfctype.IbcPacketBlock).Packets {
+				stages = append(stages, packet.Stage)
+			}
+			assert.Equal(t, test.expectedStages, stages)
+		})
+	}
+}
+
+func TestIbcChannelIndexer(t *testing.T) {
+	keys, err := IndexKeys(IbcChannelIndexShortName, ibcBlock())
+	require.NoError(t, err)
+
+	sort.Strings(keys)
+	assert.Equal(t, []string{"transfer/channel-141"}, keys)
+
+	bitmaps := testBitmapGetter{}
+	for _, key := range keys {
+		bitmaps[key] = roaring64.BitmapOf(12000001)
+	}
+	assert.Equal(t, []uint64{12000001}, getIbcChannelFilterFunc(map[string]bool{"channel-141": true}, nil)(bitmaps))
+	assert.Equal(t, []uint64{12000001}, getIbcChannelFilterFunc(nil, map[string]bool{"transfer": true})(bitmaps))
+	assert.Nil(t, getIbcChannelFilterFunc(map[string]bool{"channel-0": true}, nil)(bitmaps))
+}
@@ -0,0 +1,129 @@
+package transform
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+)
+
+// IndexFile describes an index bundle file, named `{baseBlockNum}.{size}.{shortName}.idx`. The files of a sharded
+// index are in the `{shortName}/{shard}` sub store of the index store.
+type IndexFile struct {
+	BaseBlockNum uint64
+	Size         uint64
+	ShortName    string
+	Shard        string
+}
+
+// Name returns the name of the index file, without the path of its shard
+func (f IndexFile) Name() string {
+	return fmt.Sprintf("%010d.%d.%s.idx", f.BaseBlockNum, f.Size, f.ShortName)
+}
+
+// Filename returns the path of the index file in the index store
+func (f IndexFile) Filename() string {
+	if f.Shard == "" {
+		return f.Name()
+	}
+	return path.Join(f.ShortName, f.Shard, f.Name())
+}
+
+// StopBlockNum returns the exclusive upper bound of the blocks covered by the index file
+func (f IndexFile) StopBlockNum() uint64 {
+	return f.BaseBlockNum + f.Size
+}
+
+// ParseIndexFilename parses the path of an index file in the index store
+func ParseIndexFilename(filename string) (out IndexFile, err error) {
+	dir, name := path.Split(filename)
+	parts := strings.Split(name, ".")
+	if len(parts) != 4 || parts[3] != "idx" {
+		return out, fmt.Errorf("invalid index filename %q", filename)
+	}
+	if out.BaseBlockNum, err = strconv.ParseUint(parts[0], 10, 64); err != nil {
+		return out, fmt.Errorf("invalid base block num in index filename %q: %w", filename, err)
+	}
+	if out.Size, err = strconv.ParseUint(parts[1], 10, 64); err != nil {
+		return out, fmt.Errorf("invalid size in index filename %q: %w", filename, err)
+	}
+	if out.Size == 0 {
+		return out, fmt.Errorf("invalid zero size in index filename %q", filename)
+	}
+	out.ShortName = parts[2]
+
+	if dir != "" {
+		dirParts := strings.Split(strings.TrimSuffix(dir, "/"), "/")
+		if len(dirParts) != 2 || dirParts[0] != out.ShortName || dirParts[1] == "" {
+			return out, fmt.Errorf("invalid index file path %q, expecting %s/{shard}/%s", filename, out.ShortName, name)
+		}
+		out.Shard = dirParts[1]
+	}
+	return out, nil
+}
+
+// ListIndexFiles returns the index files found in store, sorted by short name, size, base block num and shard, along
+// with the files of the store which are not index files
+func ListIndexFiles(ctx context.Context, store dstore.Store) (out []IndexFile, unknown []string, err error) {
+	err = store.Walk(ctx, "", func(filename string) error {
+		f, err := ParseIndexFilename(filename)
+		if err != nil {
+			zlog.Warn("unknown file in index store", zap.String("filename", filename), zap.Error(err))
+			unknown = append(unknown, filename)
+			return nil
+		}
+		out = append(out, f)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sortIndexFiles(out)
+	return out, unknown, nil
+}
+
+// ListIndexFilesFrom returns the index files at the top level of store (the files of the unsharded indexes, or the
+// files of a shard store) with a base block num at or after startBlock, sorted like ListIndexFiles. Those files are
+// named after their base block num, sorting before the sub stores of the sharded indexes, so that the walk stops
+// before reaching them.
+func ListIndexFilesFrom(ctx context.Context, store dstore.Store, startBlock uint64) (out []IndexFile, err error) {
+	err = store.WalkFrom(ctx, "", fmt.Sprintf("%010d", startBlock), func(filename string) error {
+		if filename[0] > '9' {
+			return dstore.StopIteration
+		}
+		f, err := ParseIndexFilename(filename)
+		if err != nil || f.Shard != "" || f.BaseBlockNum < startBlock {
+			zlog.Debug("skipping unknown file in index store", zap.String("filename", filename))
+			return nil
+		}
+		out = append(out, f)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortIndexFiles(out)
+	return out, nil
+}
+
+func sortIndexFiles(files []IndexFile) {
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].ShortName != files[j].ShortName {
+			return files[i].ShortName < files[j].ShortName
+		}
+		if files[i].Size != files[j].Size {
+			return files[i].Size < files[j].Size
+		}
+		if files[i].BaseBlockNum != files[j].BaseBlockNum {
+			return files[i].BaseBlockNum < files[j].BaseBlockNum
+		}
+		return files[i].Shard < files[j].Shard
+	})
+}
@@ -0,0 +1,122 @@
+package transform
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/streamingfast/dstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIndexFilename(t *testing.T) {
+	tests := []struct {
+		name      string
+		filename  string
+		expected  IndexFile
+		expectErr bool
+	}{
+		{"index file", "0000001000.1000.msgtype.idx", IndexFile{BaseBlockNum: 1000, Size: 1000, ShortName: "msgtype"}, false},
+		{"shard file", "txhash/9D/0000000100.100.txhash.idx", IndexFile{BaseBlockNum: 100, Size: 100, ShortName: "txhash", Shard: "9D"}, false},
+		{"shard of another index", "msgtype/9D/0000000100.100.txhash.idx", IndexFile{}, true},
+		{"missing shard", "txhash/0000000100.100.txhash.idx", IndexFile{}, true},
+		{"nested shard", "txhash/9D/00/0000000100.100.txhash.idx", IndexFile{}, true},
+		{"not an index file", "0000000100.100.txhash.json", IndexFile{}, true},
+		{"invalid base block num", "abc.100.txhash.idx", IndexFile{}, true},
+		{"invalid size", "0000000100.abc.txhash.idx", IndexFile{}, true},
+		{"zero size", "0000000100.0.txhash.idx", IndexFile{}, true},
+		{"extra part", "0000000100.100.txhash.v2.idx", IndexFile{}, true},
+		{"merged blocks file", "0000000100", IndexFile{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := ParseIndexFilename(test.filename)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, f)
+			assert.Equal(t, test.filename, f.Filename())
+		})
+	}
+}
+
+var testIndexFilenames = []string{
+	"txhash/9D/0000000100.100.txhash.idx",
+	"txhash/00/0000000100.100.txhash.idx",
+	"txhash/9D/0000000000.100.txhash.idx",
+	"txhash/9D/notes.txt",
+	"0000000100.100.msgtype.idx",
+	"0000000000.1000.msgtype.idx",
+	"0000000200.100.blocktime.idx",
+}
+
+func TestListIndexFiles(t *testing.T) {
+	ctx := context.Background()
+	store, err := dstore.NewStore(t.TempDir(), "", "", false)
+	require.NoError(t, err)
+	for _, filename := range testIndexFilenames {
+		require.NoError(t, store.WriteObject(ctx, filename, strings.NewReader("index")))
+	}
+
+	files, unknown, err := ListIndexFiles(ctx, store)
+	require.NoError(t, err)
+	assert.Equal(t, []IndexFile{
+		{BaseBlockNum: 200, Size: 100, ShortName: "blocktime"},
+		{BaseBlockNum: 100, Size: 100, ShortName: "msgtype"},
+		{BaseBlockNum: 0, Size: 1000, ShortName: "msgtype"},
+		{BaseBlockNum: 0, Size: 100, ShortName: "txhash", Shard: "9D"},
+		{BaseBlockNum: 100, Size: 100, ShortName: "txhash", Shard: "00"},
+		{BaseBlockNum: 100, Size: 100, ShortName: "txhash", Shard: "9D"},
+	}, files)
+	assert.Equal(t, []string{"txhash/9D/notes.txt"}, unknown)
+}
+
+// walkCountingStore counts the files walked in a store
+type walkCountingStore struct {
+	dstore.Store
+	walked []string
+}
+
+func (s *walkCountingStore) WalkFrom(ctx context.Context, prefix, startingPoint string, f func(filename string) error) error {
+	return s.Store.WalkFrom(ctx, prefix, startingPoint, func(filename string) error {
+		s.walked = append(s.walked, filename)
+		return f(filename)
+	})
+}
+
+func TestListIndexFilesFrom(t *testing.T) {
+	// The mock store walks the files in lexical order, like the remote stores
+	mockStore := dstore.NewMockStore(nil)
+	for _, filename := range testIndexFilenames {
+		mockStore.SetFile(filename, []byte("index"))
+	}
+	store := &walkCountingStore{Store: mockStore}
+
+	files, err := ListIndexFilesFrom(context.Background(), store, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []IndexFile{
+		{BaseBlockNum: 200, Size: 100, ShortName: "blocktime"},
+		{BaseBlockNum: 100, Size: 100, ShortName: "msgtype"},
+		{BaseBlockNum: 0, Size: 1000, ShortName: "msgtype"},
+	}, files)
+	// The walk stops at the first file of the shard sub stores
+	assert.Equal(t, []string{
+		"0000000000.1000.msgtype.idx",
+		"0000000100.100.msgtype.idx",
+		"0000000200.100.blocktime.idx",
+		"txhash/00/0000000100.100.txhash.idx",
+	}, store.walked)
+
+	store.walked = nil
+	files, err = ListIndexFilesFrom(context.Background(), store, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []IndexFile{
+		{BaseBlockNum: 200, Size: 100, ShortName: "blocktime"},
+		{BaseBlockNum: 100, Size: 100, ShortName: "msgtype"},
+	}, files)
+	assert.Len(t, store.walked, 3)
+}
@@ -0,0 +1,237 @@
+package transform
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+)
+
+// Indexer extracts keys from blocks and adds them to the index files it manages
+type Indexer interface {
+	ProcessBlock(block *pbcosmos.Block)
+}
+
+// BlockIndexer receives the keys found in each block, it is usually a `transform.BlockIndexer` writing index files
+type BlockIndexer interface {
+	Add(keys []string, blockNum uint64)
+}
+
+// IndexerFactory creates an Indexer sending the keys it extracts from blocks to blockIndexer
+type IndexerFactory func(blockIndexer BlockIndexer) Indexer
+
+var indexerFactories = make(map[string]IndexerFactory)
+
+// RegisterIndexer makes an indexer available under the short name of the index it produces
+func RegisterIndexer(shortName string, factory IndexerFactory) {
+	if _, ok := indexerFactories[shortName]; ok {
+		panic(fmt.Sprintf("indexer %q already registered", shortName))
+	}
+	indexerFactories[shortName] = factory
+}
+
+// indexShards splits the files of an index in one sub store per shard of its keys
+type indexShards struct {
+	names   []string
+	shardOf func(key string) string
+}
+
+var shardedIndexes = make(map[string]*indexShards)
+
+// RegisterShardedIndexer makes an indexer available like RegisterIndexer, the files of its index being written in
+// the sub stores `{shortName}/{shard}` of the index store, so that looking up a key only reads the files of its
+// shard. shardOf returns the shard of a key, one of shards.
+func RegisterShardedIndexer(shortName string, shards []string, shardOf func(key string) string, factory IndexerFactory) {
+	RegisterIndexer(shortName, factory)
+	shardedIndexes[shortName] = &indexShards{names: shards, shardOf: shardOf}
+}
+
+// IndexShards returns the shards of the index registered under shortName, nil when its files are not sharded
+func IndexShards(shortName string) []string {
+	if shards := shardedIndexes[shortName]; shards != nil {
+		return shards.names
+	}
+	return nil
+}
+
+// IndexShardStore returns the sub store holding the files of a shard of a sharded index
+func IndexShardStore(indexStore dstore.Store, shortName string, shard string) (dstore.Store, error) {
+	store, err := indexStore.SubStore(path.Join(shortName, shard))
+	if err != nil {
+		return nil, fmt.Errorf("getting %s index shard %q store: %w", shortName, shard, err)
+	}
+	return store, nil
+}
+
+// NewIndexer creates the indexer registered under shortName
+func NewIndexer(shortName string, indexStore dstore.Store, indexSize uint64, startBlock uint64) (Indexer, error) {
+	factory, ok := indexerFactories[shortName]
+	if !ok {
+		return nil, fmt.Errorf("no indexer registered for %q, valid values are %v", shortName, RegisteredIndexers())
+	}
+
+	shards := shardedIndexes[shortName]
+	if shards == nil {
+		bi := transform.NewBlockIndexer(
+			indexStore,
+			indexSize,
+			shortName,
+			transform.WithDefinedStartBlock(startBlock),
+		)
+		return factory(bi), nil
+	}
+
+	sharded := &shardedBlockIndexer{
+		shards:   shards,
+		indexers: make(map[string]BlockIndexer, len(shards.names)),
+	}
+	for _, shard := range shards.names {
+		shardStore, err := IndexShardStore(indexStore, shortName, shard)
+		if err != nil {
+			return nil, err
+		}
+		sharded.indexers[shard] = transform.NewBlockIndexer(
+			shardStore,
+			indexSize,
+			shortName,
+			transform.WithDefinedStartBlock(startBlock),
+		)
+	}
+	return factory(sharded), nil
+}
+
+// shardedBlockIndexer sends the keys to the block indexer of their shard. Every shard receives every block, so that
+// the files of all the shards are written for each indexed range, the last shard being written last.
+type shardedBlockIndexer struct {
+	shards   *indexShards
+	indexers map[string]BlockIndexer
+}
+
+func (i *shardedBlockIndexer) Add(keys []string, blockNum uint64) {
+	byShard := make(map[string][]string)
+	for _, key := range keys {
+		shard := i.shards.shardOf(key)
+		byShard[shard] = append(byShard[shard], key)
+	}
+	for _, shard := range i.shards.names {
+		i.indexers[shard].Add(byShard[shard], blockNum)
+	}
+}
+
+// IndexKeys returns the keys that the indexer registered under shortName extracts from block
+func IndexKeys(shortName string, block *pbcosmos.Block) ([]string, error) {
+	factory, ok := indexerFactories[shortName]
+	if !ok {
+		return nil, fmt.Errorf("no indexer registered for %q, valid values are %v", shortName, RegisteredIndexers())
+	}
+	recorder := &keysRecorder{}
+	factory(recorder).ProcessBlock(block)
+	return recorder.keys, nil
+}
+
+type keysRecorder struct {
+	keys []string
+}
+
+func (r *keysRecorder) Add(keys []string, blockNum uint64) {
+	r.keys = append(r.keys, keys...)
+}
+
+// RegisteredIndexers returns the sorted short names of all registered indexers
+func RegisteredIndexers() []string {
+	var out []string
+	for shortName := range indexerFactories {
+		out = append(out, shortName)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// IndexerSet feeds blocks to multiple indexers, each one resuming from its own first unindexed block
+type IndexerSet struct {
+	indexers   []*resumedIndexer
+	startBlock uint64
+}
+
+type resumedIndexer struct {
+	shortName  string
+	startBlock uint64
+	indexer    Indexer
+}
+
+// NewIndexerSet creates the indexers registered under shortNames, looking for existing index bundles of
+// lookupIndexSizes in indexStore to find where each one should resume from startBlock. Indexers already
+// done up to stopBlock (exclusive, 0 meaning unbounded) are left out of the set. An indexer resuming within
+// an index bundle starts at the next one, the blocks of the bundle before it not being indexed, unless it
+// resumes at the first streamable block.
+func NewIndexerSet(
+	ctx context.Context,
+	indexStore dstore.Store,
+	shortNames []string,
+	indexSize uint64,
+	lookupIndexSizes []uint64,
+	startBlock uint64,
+	stopBlock uint64,
+) (*IndexerSet, error) {
+	set := &IndexerSet{startBlock: math.MaxUint64}
+	for _, shortName := range shortNames {
+		// The files of the last shard of a sharded index are written once the ones of all the other shards are
+		resumeStore := indexStore
+		if shards := shardedIndexes[shortName]; shards != nil {
+			var err error
+			if resumeStore, err = IndexShardStore(indexStore, shortName, shards.names[len(shards.names)-1]); err != nil {
+				return nil, err
+			}
+		}
+		next := transform.FindNextUnindexed(ctx, startBlock, lookupIndexSizes, shortName, resumeStore)
+		if next%indexSize != 0 && next != bstream.GetProtocolFirstStreamableBlock {
+			aligned := next - next%indexSize + indexSize
+			zlog.Warn("start block not aligned on the index size, skipping to the next index bundle", zap.String("indexer", shortName), zap.Uint64("start_block", next), zap.Uint64("index_size", indexSize), zap.Uint64("resolved_start", aligned))
+			next = aligned
+		}
+		if stopBlock != 0 && next >= stopBlock {
+			zlog.Info("range already indexed, skipping indexer", zap.String("indexer", shortName), zap.Uint64("start_block", startBlock), zap.Uint64("stop_block", stopBlock))
+			continue
+		}
+		zlog.Info("resolved next unindexed region", zap.String("indexer", shortName), zap.Uint64("resolved_start", next))
+
+		indexer, err := NewIndexer(shortName, indexStore, indexSize, next-next%indexSize)
+		if err != nil {
+			return nil, err
+		}
+		set.indexers = append(set.indexers, &resumedIndexer{
+			shortName:  shortName,
+			startBlock: next,
+			indexer:    indexer,
+		})
+		if next < set.startBlock {
+			set.startBlock = next
+		}
+	}
+	return set, nil
+}
+
+// Len returns the number of indexers that still have blocks to process
+func (s *IndexerSet) Len() int {
+	return len(s.indexers)
+}
+
+// StartBlock returns the lowest block from which one of the indexers resumes
+func (s *IndexerSet) StartBlock() uint64 {
+	return s.startBlock
+}
+
+func (s *IndexerSet) ProcessBlock(block *pbcosmos.Block) {
+	for _, i := range s.indexers {
+		if block.Header.Height >= i.startBlock {
+			i.indexer.ProcessBlock(block)
+		}
+	}
+}
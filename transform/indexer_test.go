@@ -0,0 +1,92 @@
+package transform
+
+import (
+	"context"
+	"testing"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIndexerSet_UnalignedStartBlock(t *testing.T) {
+	ctx := context.Background()
+	defer func(first uint64) { bstream.GetProtocolFirstStreamableBlock = first }(bstream.GetProtocolFirstStreamableBlock)
+	bstream.GetProtocolFirstStreamableBlock = 1
+
+	tests := []struct {
+		name               string
+		startBlock         uint64
+		stopBlock          uint64
+		expectedLen        int
+		expectedStartBlock uint64
+		expectedFiles      []IndexFile
+	}{
+		{
+			name:               "aligned",
+			startBlock:         100,
+			expectedLen:        1,
+			expectedStartBlock: 100,
+			expectedFiles: []IndexFile{
+				{BaseBlockNum: 100, Size: 100, ShortName: BlockTimeIndexShortName},
+				{BaseBlockNum: 200, Size: 100, ShortName: BlockTimeIndexShortName},
+			},
+		},
+		{
+			name:               "first streamable block",
+			startBlock:         0,
+			expectedLen:        1,
+			expectedStartBlock: 1,
+			expectedFiles: []IndexFile{
+				{BaseBlockNum: 0, Size: 100, ShortName: BlockTimeIndexShortName},
+				{BaseBlockNum: 100, Size: 100, ShortName: BlockTimeIndexShortName},
+				{BaseBlockNum: 200, Size: 100, ShortName: BlockTimeIndexShortName},
+			},
+		},
+		{
+			name:               "within a bundle",
+			startBlock:         150,
+			expectedLen:        1,
+			expectedStartBlock: 200,
+			expectedFiles: []IndexFile{
+				{BaseBlockNum: 200, Size: 100, ShortName: BlockTimeIndexShortName},
+			},
+		},
+		{
+			name:        "within the last bundle of the range",
+			startBlock:  150,
+			stopBlock:   200,
+			expectedLen: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			indexStore, err := dstore.NewStore(t.TempDir(), "", "", false)
+			require.NoError(t, err)
+
+			set, err := NewIndexerSet(ctx, indexStore, []string{BlockTimeIndexShortName}, 100, []uint64{100}, test.startBlock, test.stopBlock)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedLen, set.Len())
+			if test.expectedLen == 0 {
+				return
+			}
+			assert.Equal(t, test.expectedStartBlock, set.StartBlock())
+
+			for height := set.StartBlock(); height <= 300; height++ {
+				set.ProcessBlock(&pbcosmos.Block{Header: &pbcosmos.Header{
+					Height: height,
+					Time:   &pbcosmos.Timestamp{Seconds: 1663000000 + int64(height)},
+				}})
+			}
+
+			files, unknown, err := ListIndexFiles(ctx, indexStore)
+			require.NoError(t, err)
+			assert.Empty(t, unknown)
+			assert.Equal(t, test.expectedFiles, files)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package transform
+
+import (
+	"encoding/json"
+	"strconv"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+// MsgIndexAttributeKey is the attribute added by recent Cosmos SDK versions to every event emitted while executing a message
+const MsgIndexAttributeKey = "msg_index"
+
+// txLevelEvent marks an event that is not attached to any message, ex: fees and signatures checks of the ante handler
+const txLevelEvent = -1
+
+// abciMessageLog is the entry for one message in the JSON ABCI log of a successful transaction, the
+// events of each message are stringified: the attributes of events sharing the same type are merged.
+type abciMessageLog struct {
+	MsgIndex uint32 `json:"msg_index"`
+	Events   []struct {
+		Type       string `json:"type"`
+		Attributes []struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"attributes"`
+	} `json:"events"`
+}
+
+// EventMessageIndexes returns, for every event of the transaction result, the index of the message that emitted
+// it or -1 for events emitted outside of messages execution. The `msg_index` attributes of the events are used
+// when present, otherwise the events are correlated with the ABCI log. It returns false when the events cannot
+// be correlated with their messages.
+func EventMessageIndexes(result *pbcosmos.ResponseDeliverTx) ([]int, bool) {
+	if result == nil {
+		return nil, false
+	}
+	if out, ok := msgIndexesFromAttributes(result.Events); ok {
+		return out, true
+	}
+	return msgIndexesFromLog(result.Events, result.Log)
+}
+
+func msgIndexesFromAttributes(events []*pbcosmos.Event) ([]int, bool) {
+	out := make([]int, len(events))
+	found := false
+	for i, event := range events {
+		out[i] = txLevelEvent
+		for _, attr := range event.Attributes {
+			if string(attr.Key) != MsgIndexAttributeKey {
+				continue
+			}
+			msgIndex, err := strconv.ParseUint(string(attr.Value), 10, 32)
+			if err != nil {
+				return nil, false
+			}
+			out[i] = int(msgIndex)
+			found = true
+			break
+		}
+	}
+	return out, found
+}
+
+// msgIndexesFromLog relies on the events of the messages being the last ones of the transaction result, in
+// messages order, after the ones of the ante handler. Going backward from the last message, the events of each
+// message are the ones holding as many attributes as its log entry.
+func msgIndexesFromLog(events []*pbcosmos.Event, log string) ([]int, bool) {
+	var messageLogs []abciMessageLog
+	if err := json.Unmarshal([]byte(log), &messageLogs); err != nil || len(messageLogs) == 0 {
+		return nil, false
+	}
+
+	out := make([]int, len(events))
+	for i := range out {
+		out[i] = txLevelEvent
+	}
+
+	next := len(events) - 1
+	for i := len(messageLogs) - 1; i >= 0; i-- {
+		messageLog := messageLogs[i]
+		if messageLog.MsgIndex != 0 && int(messageLog.MsgIndex) != i {
+			return nil, false
+		}
+
+		types := make(map[string]bool)
+		attributeCount := 0
+		for _, event := range messageLog.Events {
+			types[event.Type] = true
+			attributeCount += len(event.Attributes)
+		}
+
+		seenTypes := make(map[string]bool)
+		for attributeCount > 0 {
+			if next < 0 || !types[events[next].EventType] {
+				return nil, false
+			}
+			seenTypes[events[next].EventType] = true
+			attributeCount -= len(events[next].Attributes)
+			out[next] = i
+			next--
+		}
+		if attributeCount < 0 || len(seenTypes) != len(types) {
+			return nil, false
+		}
+	}
+	return out, true
+}
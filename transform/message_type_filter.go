@@ -6,6 +6,7 @@ import (
 	"github.com/streamingfast/bstream"
 	"github.com/streamingfast/bstream/transform"
 	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -15,7 +16,9 @@ import (
 
 var MessageTypeFilterMessageName = proto.MessageName(&pbtransform.MessageTypeFilter{})
 
-func MessageTypeFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint64) *transform.Factory {
+// MessageTypeFilterFactory creates message type filters, when pruneEvents is set the events emitted by the
+// filtered out messages are removed from the transactions results as well
+func MessageTypeFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint64, pruneEvents bool) *transform.Factory {
 	return &transform.Factory{
 		Obj: &pbtransform.MessageTypeFilter{},
 		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
@@ -40,6 +43,7 @@ func MessageTypeFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint
 
 			return &MessageTypeFilter{
 				MessageTypes:       messageTypeMap,
+				PruneEvents:        pruneEvents,
 				possibleIndexSizes: possibleIndexSizes,
 				indexStore:         indexStore,
 			}, nil
@@ -48,7 +52,8 @@ func MessageTypeFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint
 }
 
 type MessageTypeFilter struct {
-	MessageTypes map[string]bool
+	MessageTypes map[string]bool // may hold patterns, see TypeWildcard
+	PruneEvents  bool
 
 	indexStore         dstore.Store
 	possibleIndexSizes []uint64
@@ -62,22 +67,46 @@ func (p *MessageTypeFilter) Transform(readOnlyBlk *bstream.Block, in transform.I
 	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
 
 	for _, tx := range block.Transactions {
-		tx.Tx.Body.Messages = p.filterMessages(tx.Tx.Body.Messages)
+		messages, keptIndexes := p.filterMessages(tx.Tx.Body.Messages)
+		if p.PruneEvents && len(messages) != len(tx.Tx.Body.Messages) {
+			p.pruneEvents(tx, keptIndexes)
+		}
+		tx.Tx.Body.Messages = messages
 	}
 
 	return block, nil
 }
 
-func (p *MessageTypeFilter) filterMessages(messages []*anypb.Any) []*anypb.Any {
+func (p *MessageTypeFilter) filterMessages(messages []*anypb.Any) ([]*anypb.Any, map[int]bool) {
 	var outMessages []*anypb.Any
+	keptIndexes := make(map[int]bool)
 
-	for _, message := range messages {
-		if p.MessageTypes[message.TypeUrl] {
+	for i, message := range messages {
+		if matchesType(p.MessageTypes, message.TypeUrl) {
 			outMessages = append(outMessages, message)
+			keptIndexes[i] = true
 		}
 	}
 
-	return outMessages
+	return outMessages, keptIndexes
+}
+
+// pruneEvents removes the events emitted by the messages that are not kept, the events emitted outside of
+// messages execution are always kept. Events are left untouched when they cannot be correlated with their messages.
+func (p *MessageTypeFilter) pruneEvents(tx *pbcosmos.TxResult, keptIndexes map[int]bool) {
+	msgIndexes, ok := EventMessageIndexes(tx.Result)
+	if !ok {
+		zlog.Debug("unable to correlate events with messages, keeping all events", zap.Uint64("height", tx.Height), zap.Uint32("index", tx.Index))
+		return
+	}
+
+	var events []*pbcosmos.Event
+	for i, event := range tx.Result.Events {
+		if msgIndexes[i] == txLevelEvent || keptIndexes[msgIndexes[i]] {
+			events = append(events, event)
+		}
+	}
+	tx.Result.Events = events
 }
 
 func (p *MessageTypeFilter) GetIndexProvider() bstream.BlockIndexProvider {
@@ -85,7 +114,7 @@ func (p *MessageTypeFilter) GetIndexProvider() bstream.BlockIndexProvider {
 		return nil
 	}
 
-	if len(p.MessageTypes) == 0 {
+	if !indexableTypes(p.MessageTypes) {
 		return nil
 	}
 
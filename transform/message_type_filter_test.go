@@ -0,0 +1,469 @@
+package transform
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbtransform "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/transform/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	msgWithdrawDelegatorReward = "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward"
+	msgDelegate                = "/cosmos.staking.v1beta1.MsgDelegate"
+	msgSend                    = "/cosmos.bank.v1beta1.MsgSend"
+	msgVote                    = "/cosmos.gov.v1beta1.MsgVote"
+
+	delegator    = "cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42"
+	validator    = "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	distribution = "cosmos1jv65s3grqf6v6jl3dp4t6c9t9rk99cd88lyufl"
+	bondedPool   = "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
+	feeCollector = "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta"
+	recipient    = "cosmos1c4k24jzduc365kywrsvf5ujz4ya6mwympnc4en"
+)
+
+func event(eventType string, keyValues ...string) *pbcosmos.Event {
+	event := &pbcosmos.Event{EventType: eventType}
+	for i := 0; i < len(keyValues); i += 2 {
+		event.Attributes = append(event.Attributes, &pbcosmos.EventAttribute{Key: []byte(keyValues[i]), Value: []byte(keyValues[i+1]), Index: true})
+	}
+	return event
+}
+
+func withMsgIndex(msgIndex string, events ...*pbcosmos.Event) []*pbcosmos.Event {
+	for _, event := range events {
+		event.Attributes = append(event.Attributes, &pbcosmos.EventAttribute{Key: []byte(MsgIndexAttributeKey), Value: []byte(msgIndex), Index: true})
+	}
+	return events
+}
+
+func txResult(messageTypes []string, result *pbcosmos.ResponseDeliverTx) *pbcosmos.TxResult {
+	body := &pbcosmos.TxBody{}
+	for _, messageType := range messageTypes {
+		body.Messages = append(body.Messages, &anypb.Any{TypeUrl: messageType})
+	}
+	return &pbcosmos.TxResult{
+		Height: 12000000,
+		Tx:     &pbcosmos.Tx{Body: body},
+		Result: result,
+	}
+}
+
+func anteEvents() []*pbcosmos.Event {
+	return []*pbcosmos.Event{
+		event("coin_spent", "spender", delegator, "amount", "2500uatom"),
+		event("coin_received", "receiver", feeCollector, "amount", "2500uatom"),
+		event("transfer", "recipient", feeCollector, "sender", delegator, "amount", "2500uatom"),
+		event("message", "sender", delegator),
+		event("tx", "fee", "2500uatom"),
+		event("tx", "acc_seq", delegator+"/118"),
+		event("tx", "signature", "R3VuKhGSiMQC5y6f4sK7zhT2J38S9mSGQkZD4YQs1S1G3k8t9iQ9kqJ6xz4mYNFJBnXnT3jHLgC3c9t7bJsbAg=="),
+	}
+}
+
+// restakeTx is a Cosmos SDK v0.45 transaction withdrawing delegation rewards and delegating them again, its events
+// do not have a msg_index attribute and the ABCI log holds the stringified events of each message.
+func restakeTx() *pbcosmos.TxResult {
+	withdrawEvents := []*pbcosmos.Event{
+		event("message", "action", msgWithdrawDelegatorReward),
+		event("coin_spent", "spender", distribution, "amount", "1034uatom"),
+		event("coin_received", "receiver", delegator, "amount", "1034uatom"),
+		event("transfer", "recipient", delegator, "sender", distribution, "amount", "1034uatom"),
+		event("message", "sender", distribution),
+		event("withdraw_rewards", "amount", "1034uatom", "validator", validator),
+		event("message", "module", "distribution", "sender", delegator),
+	}
+	delegateEvents := []*pbcosmos.Event{
+		event("message", "action", msgDelegate),
+		event("coin_spent", "spender", delegator, "amount", "1000uatom"),
+		event("coin_received", "receiver", bondedPool, "amount", "1000uatom"),
+		event("delegate", "validator", validator, "amount", "1000uatom", "new_shares", "1000.000000000000000000"),
+		event("message", "module", "staking", "sender", delegator),
+	}
+
+	var events []*pbcosmos.Event
+	events = append(events, anteEvents()...)
+	events = append(events, withdrawEvents...)
+	events = append(events, delegateEvents...)
+
+	return txResult([]string{msgWithdrawDelegatorReward, msgDelegate}, &pbcosmos.ResponseDeliverTx{
+		GasWanted: 250000,
+		GasUsed:   198432,
+		Events:    events,
+		Log: `[{"events":[{"type":"coin_received","attributes":[{"key":"receiver","value":"` + delegator + `"},{"key":"amount","value":"1034uatom"}]},` +
+			`{"type":"coin_spent","attributes":[{"key":"spender","value":"` + distribution + `"},{"key":"amount","value":"1034uatom"}]},` +
+			`{"type":"message","attributes":[{"key":"action","value":"` + msgWithdrawDelegatorReward + `"},{"key":"sender","value":"` + distribution + `"},{"key":"module","value":"distribution"},{"key":"sender","value":"` + delegator + `"}]},` +
+			`{"type":"transfer","attributes":[{"key":"recipient","value":"` + delegator + `"},{"key":"sender","value":"` + distribution + `"},{"key":"amount","value":"1034uatom"}]},` +
+			`{"type":"withdraw_rewards","attributes":[{"key":"amount","value":"1034uatom"},{"key":"validator","value":"` + validator + `"}]}]},` +
+			`{"msg_index":1,"events":[{"type":"coin_received","attributes":[{"key":"receiver","value":"` + bondedPool + `"},{"key":"amount","value":"1000uatom"}]},` +
+			`{"type":"coin_spent","attributes":[{"key":"spender","value":"` + delegator + `"},{"key":"amount","value":"1000uatom"}]},` +
+			`{"type":"delegate","attributes":[{"key":"validator","value":"` + validator + `"},{"key":"amount","value":"1000uatom"},{"key":"new_shares","value":"1000.000000000000000000"}]},` +
+			`{"type":"message","attributes":[{"key":"action","value":"` + msgDelegate + `"},{"key":"module","value":"staking"},{"key":"sender","value":"` + delegator + `"}]}]}]`,
+	})
+}
+
+// sendAndVoteTx is a Cosmos SDK v0.47 transaction sending tokens and voting on a proposal, every event emitted
+// while executing a message has a msg_index attribute.
+func sendAndVoteTx() *pbcosmos.TxResult {
+	var events []*pbcosmos.Event
+	events = append(events, anteEvents()...)
+	events = append(events, withMsgIndex("0",
+		event("message", "action", msgSend, "sender", delegator, "module", "bank"),
+		event("coin_spent", "spender", delegator, "amount", "150000uatom"),
+		event("coin_received", "receiver", recipient, "amount", "150000uatom"),
+		event("transfer", "recipient", recipient, "sender", delegator, "amount", "150000uatom"),
+		event("message", "sender", delegator),
+	)...)
+	events = append(events, withMsgIndex("1",
+		event("message", "action", msgVote, "sender", delegator, "module", "governance"),
+		event("proposal_vote", "option", `{"option":1,"weight":"1.000000000000000000"}`, "proposal_id", "782"),
+	)...)
+
+	return txResult([]string{msgSend, msgVote}, &pbcosmos.ResponseDeliverTx{
+		GasWanted: 200000,
+		GasUsed:   121544,
+		Events:    events,
+	})
+}
+
+// failedTx is a transaction which messages failed, only the events of the ante handler are left and the ABCI
+// log holds the error.
+func failedTx() *pbcosmos.TxResult {
+	return txResult([]string{msgWithdrawDelegatorReward, msgDelegate}, &pbcosmos.ResponseDeliverTx{
+		Code:      5,
+		Codespace: "sdk",
+		GasWanted: 250000,
+		GasUsed:   142811,
+		Events:    anteEvents(),
+		Log:       "failed to execute message; message index: 1: 1000uatom is smaller than 5000uatom: insufficient funds",
+	})
+}
+
+func eventTypes(events []*pbcosmos.Event) (out []string) {
+	for _, event := range events {
+		out = append(out, event.EventType)
+	}
+	return out
+}
+
+// rpcTxResult decodes the result of a transaction as returned by the `block_results` endpoint of a node
+func rpcTxResult(t *testing.T, messageTypes []string, rawResult string) *pbcosmos.TxResult {
+	t.Helper()
+
+	var result struct {
+		Code      uint32 `json:"code"`
+		Data      []byte `json:"data"`
+		Log       string `json:"log"`
+		Info      string `json:"info"`
+		GasWanted int64  `json:"gas_wanted,string"`
+		GasUsed   int64  `json:"gas_used,string"`
+		Events    []struct {
+			Type       string `json:"type"`
+			Attributes []struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+				Index bool   `json:"index"`
+			} `json:"attributes"`
+		} `json:"events"`
+		Codespace string `json:"codespace"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(rawResult), &result))
+
+	out := txResult(messageTypes, &pbcosmos.ResponseDeliverTx{
+		Code:      result.Code,
+		Data:      result.Data,
+		Log:       result.Log,
+		Info:      result.Info,
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+		Codespace: result.Codespace,
+	})
+	for _, rpcEvent := range result.Events {
+		event := &pbcosmos.Event{EventType: rpcEvent.Type}
+		for _, attr := range rpcEvent.Attributes {
+			event.Attributes = append(event.Attributes, &pbcosmos.EventAttribute{Key: []byte(attr.Key), Value: []byte(attr.Value), Index: attr.Index})
+		}
+		out.Result.Events = append(out.Result.Events, event)
+	}
+	return out
+}
+
+// sdk045MultiSendAndVoteResult is the result of a Cosmos SDK v0.45 (Tendermint v0.34) transaction sending tokens
+// twice and voting on a proposal, laid out as returned by the node: the attributes are base64 encoded and the ABCI
+// log holds the events of each message, flattened per type and sorted by type, the msg_index of the first message
+// being omitted.
+const sdk045MultiSendAndVoteResult = `{"code":0,"data":"Ch4KHC9jb3Ntb3MuYmFuay52MWJldGExLk1zZ1NlbmQKHgocL2Nvc21vcy5iYW5rLnYxYmV0YTEuTXNnU2VuZAodChsvY29zbW9zLmdvdi52MWJldGExLk1zZ1ZvdGU=","log":"[{\"events\":[{\"type\":\"coin_received\",\"attributes\":[{\"key\":\"receiver\",\"value\":\"cosmos1c4k24jzduc365kywrsvf5ujz4ya6mwympnc4en\"},{\"key\":\"amount\",\"value\":\"150000uatom\"}]},{\"type\":\"coin_spent\",\"attributes\":[{\"key\":\"spender\",\"value\":\"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42\"},{\"key\":\"amount\",\"value\":\"150000uatom\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.bank.v1beta1.MsgSend\"},{\"key\":\"sender\",\"value\":\"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42\"},{\"key\":\"module\",\"value\":\"bank\"}]},{\"type\":\"transfer\",\"attributes\":[{\"key\":\"recipient\",\"value\":\"cosmos1c4k24jzduc365kywrsvf5ujz4ya6mwympnc4en\"},{\"key\":\"sender\",\"value\":\"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42\"},{\"key\":\"amount\",\"value\":\"150000uatom\"}]}]},{\"msg_index\":1,\"events\":[{\"type\":\"coin_received\",\"attributes\":[{\"key\":\"receiver\",\"value\":\"cosmos1z8mzakma7vnaajysmtkwt4wgjqr2m84tzvyfkz\"},{\"key\":\"amount\",\"value\":\"42000uatom\"}]},{\"type\":\"coin_spent\",\"attributes\":[{\"key\":\"spender\",\"value\":\"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42\"},{\"key\":\"amount\",\"value\":\"42000uatom\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.bank.v1beta1.MsgSend\"},{\"key\":\"sender\",\"value\":\"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42\"},{\"key\":\"module\",\"value\":\"bank\"}]},{\"type\":\"transfer\",\"attributes\":[{\"key\":\"recipient\",\"value\":\"cosmos1z8mzakma7vnaajysmtkwt4wgjqr2m84tzvyfkz\"},{\"key\":\"sender\",\"value\":\"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42\"},{\"key\":\"amount\",\"value\":\"42000uatom\"}]}]},{\"msg_index\":2,\"events\":[{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.gov.v1beta1.MsgVote\"},{\"key\":\"module\",\"value\":\"governance\"},{\"key\":\"sender\",\"value\":\"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42\"}]},{\"type\":\"proposal_vote\",\"attributes\":[{\"key\":\"option\",\"value\":\"option:VOTE_OPTION_YES weight:\\\"1.000000000000000000\\\" \"},{\"key\":\"proposal_id\",\"value\":\"782\"}]}]}]","info":"","gas_wanted":"250000","gas_used":"187641","events":[
+	{"type":"coin_spent","attributes":[{"key":"c3BlbmRlcg==","value":"Y29zbW9zMXFhYTl6ZWo5YTBnZTN1Z3B4M3B4eXg2MDJseGgzenRxZ2ZucDQy","index":true},{"key":"YW1vdW50","value":"MjUwMHVhdG9t","index":true}]},
+	{"type":"coin_received","attributes":[{"key":"cmVjZWl2ZXI=","value":"Y29zbW9zMTd4cGZ2YWttMmFtZzk2MnlsczZmODR6M2tlbGw4YzVsc2VycXRh","index":true},{"key":"YW1vdW50","value":"MjUwMHVhdG9t","index":true}]},
+	{"type":"transfer","attributes":[{"key":"cmVjaXBpZW50","value":"Y29zbW9zMTd4cGZ2YWttMmFtZzk2MnlsczZmODR6M2tlbGw4YzVsc2VycXRh","index":true},{"key":"c2VuZGVy","value":"Y29zbW9zMXFhYTl6ZWo5YTBnZTN1Z3B4M3B4eXg2MDJseGgzenRxZ2ZucDQy","index":true},{"key":"YW1vdW50","value":"MjUwMHVhdG9t","index":true}]},
+	{"type":"message","attributes":[{"key":"c2VuZGVy","value":"Y29zbW9zMXFhYTl6ZWo5YTBnZTN1Z3B4M3B4eXg2MDJseGgzenRxZ2ZucDQy","index":true}]},
+	{"type":"tx","attributes":[{"key":"ZmVl","value":"MjUwMHVhdG9t","index":true}]},
+	{"type":"tx","attributes":[{"key":"YWNjX3NlcQ==","value":"Y29zbW9zMXFhYTl6ZWo5YTBnZTN1Z3B4M3B4eXg2MDJseGgzenRxZ2ZucDQyLzExOA==","index":true}]},
+	{"type":"tx","attributes":[{"key":"c2lnbmF0dXJl","value":"UjNWdUtoR1NpTVFDNXk2ZjRzSzd6aFQySjM4UzltU0dRa1pENFlRczFTMUczazh0OWlROWtxSjZ4ejRtWU5GSkJuWG5UM2pITGdDM2M5dDdiSnNiQWc9PQ==","index":true}]},
+	{"type":"message","attributes":[{"key":"YWN0aW9u","value":"L2Nvc21vcy5iYW5rLnYxYmV0YTEuTXNnU2VuZA==","index":true}]},
+	{"type":"coin_spent","attributes":[{"key":"c3BlbmRlcg==","value":"Y29zbW9zMXFhYTl6ZWo5YTBnZTN1Z3B4M3B4eXg2MDJseGgzenRxZ2ZucDQy","index":true},{"key":"YW1vdW50","value":"MTUwMDAwdWF0b20=","index":true}]},
+	{"type":"coin_received","attributes":[{"key":"cmVjZWl2ZXI=","value":"Y29zbW9zMWM0azI0anpkdWMzNjVreXdyc3ZmNXVqejR5YTZtd3ltcG5jNGVu","index":true},{"key":"YW1vdW50","value":"MTUwMDAwdWF0b20=","index":true}]},
+	{"type":"transfer","attributes":[{"key":"cmVjaXBpZW50","value":"Y29zbW9zMWM0azI0anpkdWMzNjVreXdyc3ZmNXVqejR5YTZtd3ltcG5jNGVu","index":true},{"key":"c2VuZGVy","value":"Y29zbW9zMXFhYTl6ZWo5YTBnZTN1Z3B4M3B4eXg2MDJseGgzenRxZ2ZucDQy","index":true},{"key":"YW1vdW50","value":"MTUwMDAwdWF0b20=","index":true}]},
+	{"type":"message","attributes":[{"key":"c2VuZGVy","value":"Y29zbW9zMXFhYTl6ZWo5YTBnZTN1Z3B4M3B4eXg2MDJseGgzenRxZ2ZucDQy","index":true}]},
+	{"type":"message","attributes":[{"key":"bW9kdWxl","value":"YmFuaw==","index":true}]},
+	{"type":"message","attributes":[{"key":"YWN0aW9u","value":"L2Nvc21vcy5iYW5rLnYxYmV0YTEuTXNnU2VuZA==","index":true}]},
+	{"type":"coin_spent","attributes":[{"key":"c3BlbmRlcg==","value":"Y29zbW9zMXFhYTl6ZWo5YTBnZTN1Z3B4M3B4eXg2MDJseGgzenRxZ2ZucDQy","index":true},{"key":"YW1vdW50","value":"NDIwMDB1YXRvbQ==","index":true}]},
+	{"type":"coin_received","attributes":[{"key":"cmVjZWl2ZXI=","value":"Y29zbW9zMXo4bXpha21hN3ZuYWFqeXNtdGt3dDR3Z2pxcjJtODR0enZ5Zmt6","index":true},{"key":"YW1vdW50","value":"NDIwMDB1YXRvbQ==","index":true}]},
+	{"type":"transfer","attributes":[{"key":"cmVjaXBpZW50","value":"Y29zbW9zMXo4bXpha21hN3ZuYWFqeXNtdGt3dDR3Z2pxcjJtODR0enZ5Zmt6","index":true},{"key":"c2VuZGVy","value":"Y29zbW9zMXFhYTl6ZWo5YTBnZTN1Z3B4M3B4eXg2MDJseGgzenRxZ2ZucDQy","index":true},{"key":"YW1vdW50","value":"NDIwMDB1YXRvbQ==","index":true}]},
+	{"type":"message","attributes":[{"key":"c2VuZGVy","value":"Y29zbW9zMXFhYTl6ZWo5YTBnZTN1Z3B4M3B4eXg2MDJseGgzenRxZ2ZucDQy","index":true}]},
+	{"type":"message","attributes":[{"key":"bW9kdWxl","value":"YmFuaw==","index":true}]},
+	{"type":"message","attributes":[{"key":"YWN0aW9u","value":"L2Nvc21vcy5nb3YudjFiZXRhMS5Nc2dWb3Rl","index":true}]},
+	{"type":"proposal_vote","attributes":[{"key":"b3B0aW9u","value":"b3B0aW9uOlZPVEVfT1BUSU9OX1lFUyB3ZWlnaHQ6IjEuMDAwMDAwMDAwMDAwMDAwMDAwIiA=","index":true},{"key":"cHJvcG9zYWxfaWQ=","value":"Nzgy","index":true}]},
+	{"type":"message","attributes":[{"key":"bW9kdWxl","value":"Z292ZXJuYW5jZQ==","index":true},{"key":"c2VuZGVy","value":"Y29zbW9zMXFhYTl6ZWo5YTBnZTN1Z3B4M3B4eXg2MDJseGgzenRxZ2ZucDQy","index":true}]}
+],"codespace":""}`
+
+// sdk050SendAndDelegateResult is the result of a Cosmos SDK v0.50 (CometBFT v0.38) transaction sending tokens and
+// delegating, laid out as returned by the node: the ABCI log is empty and every event emitted while executing a
+// message has a trailing msg_index attribute, the ones of the ante handler having none.
+const sdk050SendAndDelegateResult = `{"code":0,"data":"EiYKJC9jb3Ntb3MuYmFuay52MWJldGExLk1zZ1NlbmRSZXNwb25zZRItCisvY29zbW9zLnN0YWtpbmcudjFiZXRhMS5Nc2dEZWxlZ2F0ZVJlc3BvbnNl","log":"","info":"","gas_wanted":"312500","gas_used":"201877","events":[
+	{"type":"coin_spent","attributes":[{"key":"spender","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42","index":true},{"key":"amount","value":"3125uatom","index":true}]},
+	{"type":"coin_received","attributes":[{"key":"receiver","value":"cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta","index":true},{"key":"amount","value":"3125uatom","index":true}]},
+	{"type":"transfer","attributes":[{"key":"recipient","value":"cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta","index":true},{"key":"sender","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42","index":true},{"key":"amount","value":"3125uatom","index":true}]},
+	{"type":"message","attributes":[{"key":"sender","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42","index":true}]},
+	{"type":"tx","attributes":[{"key":"fee","value":"3125uatom","index":true},{"key":"fee_payer","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42","index":true}]},
+	{"type":"tx","attributes":[{"key":"acc_seq","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42/119","index":true}]},
+	{"type":"tx","attributes":[{"key":"signature","value":"k3Tz6oJ1yqCkC2zGkE2vN7p5lQ9m8Z0v3Xq2Yc1Rr4hS6uW8aD0fG2iJ4kL6nP8qT0vX2zB4dF6hJ8lN0pR2tA==","index":true}]},
+	{"type":"message","attributes":[{"key":"action","value":"/cosmos.bank.v1beta1.MsgSend","index":true},{"key":"sender","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42","index":true},{"key":"module","value":"bank","index":true},{"key":"msg_index","value":"0","index":true}]},
+	{"type":"coin_spent","attributes":[{"key":"spender","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42","index":true},{"key":"amount","value":"150000uatom","index":true},{"key":"msg_index","value":"0","index":true}]},
+	{"type":"coin_received","attributes":[{"key":"receiver","value":"cosmos1c4k24jzduc365kywrsvf5ujz4ya6mwympnc4en","index":true},{"key":"amount","value":"150000uatom","index":true},{"key":"msg_index","value":"0","index":true}]},
+	{"type":"transfer","attributes":[{"key":"recipient","value":"cosmos1c4k24jzduc365kywrsvf5ujz4ya6mwympnc4en","index":true},{"key":"sender","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42","index":true},{"key":"amount","value":"150000uatom","index":true},{"key":"msg_index","value":"0","index":true}]},
+	{"type":"message","attributes":[{"key":"sender","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42","index":true},{"key":"msg_index","value":"0","index":true}]},
+	{"type":"message","attributes":[{"key":"action","value":"/cosmos.staking.v1beta1.MsgDelegate","index":true},{"key":"sender","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42","index":true},{"key":"module","value":"staking","index":true},{"key":"msg_index","value":"1","index":true}]},
+	{"type":"coin_spent","attributes":[{"key":"spender","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42","index":true},{"key":"amount","value":"1000000uatom","index":true},{"key":"msg_index","value":"1","index":true}]},
+	{"type":"coin_received","attributes":[{"key":"receiver","value":"cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh","index":true},{"key":"amount","value":"1000000uatom","index":true},{"key":"msg_index","value":"1","index":true}]},
+	{"type":"delegate","attributes":[{"key":"validator","value":"cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0","index":true},{"key":"delegator","value":"cosmos1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42","index":true},{"key":"amount","value":"1000000uatom","index":true},{"key":"new_shares","value":"1000000.000000000000000000","index":true},{"key":"msg_index","value":"1","index":true}]}
+],"codespace":""}`
+
+func TestEventMessageIndexes(t *testing.T) {
+	ante := []int{-1, -1, -1, -1, -1, -1, -1}
+
+	tests := []struct {
+		name     string
+		result   *pbcosmos.ResponseDeliverTx
+		expected []int
+		ok       bool
+	}{
+		{
+			name:     "from abci log",
+			result:   restakeTx().Result,
+			expected: append(append([]int{}, ante...), 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1),
+			ok:       true,
+		},
+		{
+			name:     "from msg_index attributes",
+			result:   sendAndVoteTx().Result,
+			expected: append(append([]int{}, ante...), 0, 0, 0, 0, 0, 1, 1),
+			ok:       true,
+		},
+		{
+			name:   "failed transaction",
+			result: failedTx().Result,
+			ok:     false,
+		},
+		{
+			name: "abci log not matching events",
+			result: func() *pbcosmos.ResponseDeliverTx {
+				result := restakeTx().Result
+				result.Events = result.Events[:len(result.Events)-1]
+				return result
+			}(),
+			ok: false,
+		},
+		{
+			name:   "no result",
+			result: nil,
+			ok:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msgIndexes, ok := EventMessageIndexes(test.result)
+			assert.Equal(t, test.ok, ok)
+			if test.ok {
+				assert.Equal(t, test.expected, msgIndexes)
+			}
+		})
+	}
+}
+
+func TestMessageTypeFilter_Transform(t *testing.T) {
+	tests := []struct {
+		name               string
+		tx                 *pbcosmos.TxResult
+		messageTypes       []string
+		pruneEvents        bool
+		expectedMessages   []string
+		expectedEventTypes []string
+	}{
+		{
+			name:             "prune events of dropped message from abci log",
+			tx:               restakeTx(),
+			messageTypes:     []string{msgDelegate},
+			pruneEvents:      true,
+			expectedMessages: []string{msgDelegate},
+			expectedEventTypes: []string{
+				"coin_spent", "coin_received", "transfer", "message", "tx", "tx", "tx",
+				"message", "coin_spent", "coin_received", "delegate", "message",
+			},
+		},
+		{
+			name:             "prune events of dropped message from msg_index attributes",
+			tx:               sendAndVoteTx(),
+			messageTypes:     []string{msgVote},
+			pruneEvents:      true,
+			expectedMessages: []string{msgVote},
+			expectedEventTypes: []string{
+				"coin_spent", "coin_received", "transfer", "message", "tx", "tx", "tx",
+				"message", "proposal_vote",
+			},
+		},
+		{
+			name:               "keep ante events when dropping all messages",
+			tx:                 sendAndVoteTx(),
+			messageTypes:       []string{msgDelegate},
+			pruneEvents:        true,
+			expectedMessages:   nil,
+			expectedEventTypes: eventTypes(anteEvents()),
+		},
+		{
+			name:               "keep all events when every message matches",
+			tx:                 restakeTx(),
+			messageTypes:       []string{msgDelegate, msgWithdrawDelegatorReward},
+			pruneEvents:        true,
+			expectedMessages:   []string{msgWithdrawDelegatorReward, msgDelegate},
+			expectedEventTypes: eventTypes(restakeTx().Result.Events),
+		},
+		{
+			name:               "keep all events when pruning is disabled",
+			tx:                 restakeTx(),
+			messageTypes:       []string{msgDelegate},
+			pruneEvents:        false,
+			expectedMessages:   []string{msgDelegate},
+			expectedEventTypes: eventTypes(restakeTx().Result.Events),
+		},
+		{
+			name:               "keep all events when they cannot be correlated",
+			tx:                 failedTx(),
+			messageTypes:       []string{msgDelegate},
+			pruneEvents:        true,
+			expectedMessages:   []string{msgDelegate},
+			expectedEventTypes: eventTypes(anteEvents()),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := &pbcosmos.Block{
+				Header:       &pbcosmos.Header{Height: 12000000, Hash: []byte{0x01}, Time: &pbcosmos.Timestamp{Seconds: 1663000000}},
+				Transactions: []*pbcosmos.TxResult{test.tx},
+			}
+			blk, err := codec.FromProto(block)
+			require.NoError(t, err)
+
+			messageTypes := make(map[string]bool)
+			for _, messageType := range test.messageTypes {
+				messageTypes[messageType] = true
+			}
+			filter := &MessageTypeFilter{MessageTypes: messageTypes, PruneEvents: test.pruneEvents}
+
+			output, err := filter.Transform(blk, nil)
+			require.NoError(t, err)
+
+			tx := output.(*pbcosmos.Block).Transactions[0]
+			var messages []string
+			for _, message := range tx.Tx.Body.Messages {
+				messages = append(messages, message.TypeUrl)
+			}
+			assert.Equal(t, test.expectedMessages, messages)
+			assert.Equal(t, test.expectedEventTypes, eventTypes(tx.Result.Events))
+		})
+	}
+}
+
+func TestMessageTypeFilter_NodeResults(t *testing.T) {
+	ante := []int{-1, -1, -1, -1, -1, -1, -1}
+	anteEventTypes := []string{"coin_spent", "coin_received", "transfer", "message", "tx", "tx", "tx"}
+
+	tests := []struct {
+		name               string
+		tx                 *pbcosmos.TxResult
+		attributesEncoding codec.AttributeEncoding
+		messageTypes       []string
+		expectedMsgIndexes []int
+		expectedEventTypes []string
+	}{
+		{
+			name:               "cosmos sdk v0.45 abci log",
+			tx:                 rpcTxResult(t, []string{msgSend, msgSend, msgVote}, sdk045MultiSendAndVoteResult),
+			attributesEncoding: codec.AttributeEncodingBase64,
+			messageTypes:       []string{msgVote},
+			expectedMsgIndexes: append(append([]int{}, ante...), 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1, 2, 2, 2),
+			expectedEventTypes: append(append([]string{}, anteEventTypes...), "message", "proposal_vote", "message"),
+		},
+		{
+			name:               "cosmos sdk v0.50 msg_index attributes",
+			tx:                 rpcTxResult(t, []string{msgSend, msgDelegate}, sdk050SendAndDelegateResult),
+			attributesEncoding: codec.AttributeEncodingPlain,
+			messageTypes:       []string{msgDelegate},
+			expectedMsgIndexes: append(append([]int{}, ante...), 0, 0, 0, 0, 0, 1, 1, 1, 1),
+			expectedEventTypes: append(append([]string{}, anteEventTypes...), "message", "coin_spent", "coin_received", "delegate"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := &pbcosmos.Block{
+				Header:       &pbcosmos.Header{Height: 12000000, Hash: []byte{0x01}, Time: &pbcosmos.Timestamp{Seconds: 1663000000}},
+				Transactions: []*pbcosmos.TxResult{test.tx},
+			}
+			_, err := codec.NewAttributeNormalizer(test.attributesEncoding, 0).Normalize(block)
+			require.NoError(t, err)
+
+			msgIndexes, ok := EventMessageIndexes(test.tx.Result)
+			require.True(t, ok)
+			assert.Equal(t, test.expectedMsgIndexes, msgIndexes)
+
+			blk, err := codec.FromProto(block)
+			require.NoError(t, err)
+
+			messageTypes := make(map[string]bool)
+			for _, messageType := range test.messageTypes {
+				messageTypes[messageType] = true
+			}
+			filter := &MessageTypeFilter{MessageTypes: messageTypes, PruneEvents: true}
+
+			output, err := filter.Transform(blk, nil)
+			require.NoError(t, err)
+
+			tx := output.(*pbcosmos.Block).Transactions[0]
+			var messages []string
+			for _, message := range tx.Tx.Body.Messages {
+				messages = append(messages, message.TypeUrl)
+			}
+			assert.Equal(t, test.messageTypes, messages)
+			assert.Equal(t, test.expectedEventTypes, eventTypes(tx.Result.Events))
+		})
+	}
+}
+
+func TestMessageTypeFilterFactory(t *testing.T) {
+	message, err := anypb.New(&pbtransform.MessageTypeFilter{MessageTypes: []string{msgDelegate}})
+	require.NoError(t, err)
+
+	for _, pruneEvents := range []bool{true, false} {
+		transform, err := MessageTypeFilterFactory(nil, nil, pruneEvents).NewFunc(message)
+		require.NoError(t, err)
+		assert.Equal(t, pruneEvents, transform.(*MessageTypeFilter).PruneEvents)
+	}
+}
@@ -7,8 +7,14 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
+func init() {
+	RegisterIndexer(MessageTypeIndexShortName, func(blockIndexer BlockIndexer) Indexer {
+		return &MessageTypeIndexer{BlockIndexer: blockIndexer}
+	})
+}
+
 type MessageTypeIndexer struct {
-	BlockIndexer blockIndexer
+	BlockIndexer BlockIndexer
 }
 
 func NewMessageTypeIndexer(indexStore dstore.Store, indexSize uint64, startBlock uint64) *MessageTypeIndexer {
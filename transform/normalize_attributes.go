@@ -0,0 +1,65 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+var NormalizeAttributesMessageName = proto.MessageName(&pbfctransform.NormalizeAttributes{})
+
+func NormalizeAttributesFactory() *transform.Factory {
+	return &transform.Factory{
+		Obj: &pbfctransform.NormalizeAttributes{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			if message.MessageName() != NormalizeAttributesMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", NormalizeAttributesMessageName, message.TypeUrl)
+			}
+
+			filter := &pbfctransform.NormalizeAttributes{}
+			err := proto.Unmarshal(message.Value, filter)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected unmarshal error: %w", err)
+			}
+
+			encoding := codec.AttributeEncodingAuto
+			if filter.Encoding != "" {
+				encoding = codec.AttributeEncoding(filter.Encoding)
+			}
+			if encoding != codec.AttributeEncodingBase64 && encoding != codec.AttributeEncodingAuto {
+				return nil, fmt.Errorf("invalid attribute encoding %q, expecting %q or %q", filter.Encoding, codec.AttributeEncodingBase64, codec.AttributeEncodingAuto)
+			}
+
+			return &NormalizeAttributes{
+				Normalizer: codec.NewAttributeNormalizer(encoding, filter.UntilHeight),
+			}, nil
+		},
+	}
+}
+
+// NormalizeAttributes decodes the base64 encoded event attributes of the blocks, modifying the block seen by the
+// transforms following it
+type NormalizeAttributes struct {
+	Normalizer *codec.AttributeNormalizer
+}
+
+func (p *NormalizeAttributes) String() string {
+	return fmt.Sprintf("normalize attributes (%s)", p.Normalizer)
+}
+
+func (p *NormalizeAttributes) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
+
+	if _, err := p.Normalizer.Normalize(block); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
@@ -0,0 +1,82 @@
+package transform
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/bstream/transform"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// encodeAttributes base64 encodes the event attributes of the block, as emitted by chains running Tendermint v0.34
+func encodeAttributes(block *pbcosmos.Block) *pbcosmos.Block {
+	encode := func(events []*pbcosmos.Event) {
+		for _, event := range events {
+			for _, attr := range event.Attributes {
+				attr.Key = []byte(base64.StdEncoding.EncodeToString(attr.Key))
+				attr.Value = []byte(base64.StdEncoding.EncodeToString(attr.Value))
+			}
+		}
+	}
+	if block.ResultBeginBlock != nil {
+		encode(block.ResultBeginBlock.Events)
+	}
+	for _, tx := range block.Transactions {
+		encode(tx.Result.Events)
+	}
+	if block.ResultEndBlock != nil {
+		encode(block.ResultEndBlock.Events)
+	}
+	return block
+}
+
+func TestNormalizeAttributes_Transform(t *testing.T) {
+	blk, err := codec.FromProto(encodeAttributes(governanceBlock()))
+	require.NoError(t, err)
+
+	normalize := &NormalizeAttributes{Normalizer: codec.NewAttributeNormalizer(codec.AttributeEncodingAuto, 0)}
+	output, err := normalize.Transform(blk, nil)
+	require.NoError(t, err)
+
+	block := output.(*pbcosmos.Block)
+	proposalID, ok := eventAttribute(block.ResultEndBlock.Events[0], "proposal_id")
+	assert.True(t, ok)
+	assert.Equal(t, "781", proposalID)
+}
+
+func TestNormalizeAttributes_Composition(t *testing.T) {
+	registry := transform.NewRegistry()
+	registry.Register(NormalizeAttributesFactory())
+	registry.Register(GovernanceFilterFactory(nil, nil))
+
+	normalize, err := anypb.New(&pbfctransform.NormalizeAttributes{Encoding: "base64"})
+	require.NoError(t, err)
+	governance, err := anypb.New(&pbfctransform.GovernanceFilter{ProposalIds: []uint64{782}})
+	require.NoError(t, err)
+
+	preprocess, _, _, err := registry.BuildFromTransforms([]*anypb.Any{normalize, governance})
+	require.NoError(t, err)
+
+	blk, err := codec.FromProto(encodeAttributes(governanceBlock()))
+	require.NoError(t, err)
+
+	output, err := preprocess(blk)
+	require.NoError(t, err)
+
+	records := output.(*pbfctype.GovernanceBlock).Records
+	require.Len(t, records, 2)
+	assert.Equal(t, delegator, records[0].Address)
+	assert.Equal(t, pbfctype.GovernanceRecordType_GOVERNANCE_RECORD_TYPE_VOTING_PERIOD_END, records[1].Type)
+
+	plain, err := anypb.New(&pbfctransform.NormalizeAttributes{Encoding: "plain"})
+	require.NoError(t, err)
+	_, err = registry.New(plain)
+	assert.Error(t, err)
+}
@@ -0,0 +1,194 @@
+package transform
+
+import (
+	"fmt"
+
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const MsgWithdrawValidatorCommission = "/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission"
+
+// stakingRecordTypes maps the events emitted by the staking and distribution modules to their record type
+var stakingRecordTypes = map[string]pbfctype.StakingRecordType{
+	"create_validator":            pbfctype.StakingRecordType_STAKING_RECORD_TYPE_CREATE_VALIDATOR,
+	"delegate":                    pbfctype.StakingRecordType_STAKING_RECORD_TYPE_DELEGATE,
+	"unbond":                      pbfctype.StakingRecordType_STAKING_RECORD_TYPE_UNDELEGATE,
+	"redelegate":                  pbfctype.StakingRecordType_STAKING_RECORD_TYPE_REDELEGATE,
+	"cancel_unbonding_delegation": pbfctype.StakingRecordType_STAKING_RECORD_TYPE_CANCEL_UNDELEGATION,
+	"complete_unbonding":          pbfctype.StakingRecordType_STAKING_RECORD_TYPE_COMPLETE_UNDELEGATION,
+	"complete_redelegation":       pbfctype.StakingRecordType_STAKING_RECORD_TYPE_COMPLETE_REDELEGATION,
+	"withdraw_rewards":            pbfctype.StakingRecordType_STAKING_RECORD_TYPE_WITHDRAW_REWARDS,
+	"withdraw_commission":         pbfctype.StakingRecordType_STAKING_RECORD_TYPE_WITHDRAW_COMMISSION,
+	"rewards":                     pbfctype.StakingRecordType_STAKING_RECORD_TYPE_REWARDS,
+	"commission":                  pbfctype.StakingRecordType_STAKING_RECORD_TYPE_COMMISSION,
+	"proposer_reward":             pbfctype.StakingRecordType_STAKING_RECORD_TYPE_PROPOSER_REWARD,
+}
+
+// StakingRecords extracts the staking and distribution records from the events of the block
+func StakingRecords(block *pbcosmos.Block) *pbfctype.StakingBlock {
+	out := &pbfctype.StakingBlock{}
+	if block.Header != nil {
+		out.Height = block.Header.Height
+		out.Hash = block.Header.Hash
+		if block.Header.Time != nil {
+			out.Time = &timestamppb.Timestamp{Seconds: block.Header.Time.Seconds, Nanos: block.Header.Time.Nanos}
+		}
+	}
+
+	if block.ResultBeginBlock != nil {
+		out.Records = append(out.Records, stakingRecordsFromEvents(block.ResultBeginBlock.Events, pbfctype.EventOrigin_EVENT_ORIGIN_BEGIN_BLOCK, nil)...)
+	}
+	for _, tx := range block.Transactions {
+		if tx.Result == nil {
+			continue
+		}
+		out.Records = append(out.Records, stakingRecordsFromEvents(tx.Result.Events, pbfctype.EventOrigin_EVENT_ORIGIN_DELIVER_TX, tx)...)
+	}
+	if block.ResultEndBlock != nil {
+		out.Records = append(out.Records, stakingRecordsFromEvents(block.ResultEndBlock.Events, pbfctype.EventOrigin_EVENT_ORIGIN_END_BLOCK, nil)...)
+	}
+
+	return out
+}
+
+// isAllocationRecord checks if a record is a rewards or commission allocation of the begin blocker, which names every
+// active validator in every block
+func isAllocationRecord(record *pbfctype.StakingRecord) bool {
+	switch record.Type {
+	case pbfctype.StakingRecordType_STAKING_RECORD_TYPE_REWARDS,
+		pbfctype.StakingRecordType_STAKING_RECORD_TYPE_COMMISSION,
+		pbfctype.StakingRecordType_STAKING_RECORD_TYPE_PROPOSER_REWARD:
+		return true
+	}
+	return false
+}
+
+// stakingRecordsFromEvents builds a record for every staking and distribution event. Up to v0.46, the delegator is
+// not part of the events, the sender of the message emitting them is used instead: the one of the `message` event
+// emitted by the module handling the message, the bank transfers it causes emitting their own `message` events. The
+// validator of commission withdrawals is taken from the message, their events not holding it.
+func stakingRecordsFromEvents(events []*pbcosmos.Event, origin pbfctype.EventOrigin, tx *pbcosmos.TxResult) (out []*pbfctype.StakingRecord) {
+	var msgIndexes []int
+	correlated := false
+	if tx != nil {
+		msgIndexes, correlated = EventMessageIndexes(tx.Result)
+	}
+	msgIndexOf := func(i int) int {
+		if correlated {
+			return msgIndexes[i]
+		}
+		return txLevelEvent
+	}
+
+	senders := make(map[int]string)
+	moduleSenders := make(map[int]string)
+	for i, event := range events {
+		if event.EventType != "message" {
+			continue
+		}
+		sender, ok := eventAttribute(event, "sender")
+		if !ok {
+			continue
+		}
+		if _, found := senders[msgIndexOf(i)]; !found {
+			senders[msgIndexOf(i)] = sender
+		}
+		if _, isModule := eventAttribute(event, "module"); isModule {
+			if _, found := moduleSenders[msgIndexOf(i)]; !found {
+				moduleSenders[msgIndexOf(i)] = sender
+			}
+		}
+	}
+
+	for i, event := range events {
+		recordType, ok := stakingRecordTypes[event.EventType]
+		if !ok {
+			continue
+		}
+
+		record := stakingRecordFromEvent(recordType, event)
+		record.Origin = origin
+		record.MsgIndex = int32(msgIndexOf(i))
+		if tx != nil {
+			record.TxHash = tx.Hash
+			record.TxIndex = tx.Index
+
+			switch recordType {
+			case pbfctype.StakingRecordType_STAKING_RECORD_TYPE_WITHDRAW_COMMISSION:
+				if record.Validator == "" && record.MsgIndex != txLevelEvent && tx.Tx != nil && tx.Tx.Body != nil && int(record.MsgIndex) < len(tx.Tx.Body.Messages) {
+					validator, err := withdrawCommissionValidator(tx.Tx.Body.Messages[record.MsgIndex])
+					if err != nil {
+						zlog.Debug("skipping invalid withdraw commission message", zap.Uint64("height", tx.Height), zap.Uint32("index", tx.Index), zap.Error(err))
+					}
+					record.Validator = validator
+				}
+			default:
+				if record.Delegator == "" {
+					record.Delegator = moduleSenders[msgIndexOf(i)]
+				}
+				if record.Delegator == "" {
+					record.Delegator = senders[msgIndexOf(i)]
+				}
+			}
+		}
+		out = append(out, record)
+	}
+	return out
+}
+
+func stakingRecordFromEvent(recordType pbfctype.StakingRecordType, event *pbcosmos.Event) *pbfctype.StakingRecord {
+	record := &pbfctype.StakingRecord{Type: recordType}
+	for _, attr := range event.Attributes {
+		value := string(attr.Value)
+		switch string(attr.Key) {
+		case "delegator":
+			record.Delegator = value
+		case "validator", "destination_validator":
+			record.Validator = value
+		case "source_validator":
+			record.SourceValidator = value
+		case "amount":
+			record.Amount = value
+		case "completion_time":
+			record.CompletionTime = value
+		}
+	}
+	return record
+}
+
+// withdrawCommissionValidator decodes the validator address of a MsgWithdrawValidatorCommission, returning an empty
+// address for other messages
+func withdrawCommissionValidator(message *anypb.Any) (string, error) {
+	if message.TypeUrl != MsgWithdrawValidatorCommission {
+		return "", nil
+	}
+
+	data := message.Value
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return "", fmt.Errorf("decoding %s: %w", message.TypeUrl, protowire.ParseError(n))
+		}
+		data = data[n:]
+
+		if num == 1 && typ == protowire.BytesType {
+			value, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return "", fmt.Errorf("decoding %s: %w", message.TypeUrl, protowire.ParseError(n))
+			}
+			return string(value), nil
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, data)
+		if n < 0 {
+			return "", fmt.Errorf("decoding %s: %w", message.TypeUrl, protowire.ParseError(n))
+		}
+		data = data[n:]
+	}
+	return "", nil
+}
@@ -0,0 +1,106 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+var StakingFilterMessageName = proto.MessageName(&pbfctransform.StakingFilter{})
+
+func StakingFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint64) *transform.Factory {
+	return &transform.Factory{
+		Obj: &pbfctransform.StakingFilter{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			if message.MessageName() != StakingFilterMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", StakingFilterMessageName, message.TypeUrl)
+			}
+
+			filter := &pbfctransform.StakingFilter{}
+			err := proto.Unmarshal(message.Value, filter)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected unmarshal error: %w", err)
+			}
+
+			delegatorMap := make(map[string]bool)
+			for _, delegator := range filter.Delegators {
+				delegatorMap[delegator] = true
+			}
+
+			validatorMap := make(map[string]bool)
+			for _, validator := range filter.Validators {
+				validatorMap[validator] = true
+			}
+
+			return &StakingFilter{
+				Delegators:         delegatorMap,
+				Validators:         validatorMap,
+				possibleIndexSizes: possibleIndexSizes,
+				indexStore:         indexStore,
+			}, nil
+		},
+	}
+}
+
+// StakingFilter outputs the staking records of the block involving one of the Delegators or Validators, all of them
+// when both are empty. The source validator of redelegations is matched along with their destination. The rewards and
+// commission allocated by the begin blocker are only output when both are empty, as they name every active validator
+// in every block.
+type StakingFilter struct {
+	Delegators map[string]bool
+	Validators map[string]bool
+
+	indexStore         dstore.Store
+	possibleIndexSizes []uint64
+}
+
+func (p *StakingFilter) String() string {
+	return fmt.Sprintf("delegators: %v, validators: %v", p.Delegators, p.Validators)
+}
+
+func (p *StakingFilter) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
+
+	out := StakingRecords(block)
+	if len(p.Delegators) == 0 && len(p.Validators) == 0 {
+		return out, nil
+	}
+
+	records := out.Records
+	out.Records = nil
+	for _, record := range records {
+		if isAllocationRecord(record) {
+			continue
+		}
+		if p.Delegators[record.Delegator] || p.Validators[record.Validator] || p.Validators[record.SourceValidator] {
+			out.Records = append(out.Records, record)
+		}
+	}
+
+	return out, nil
+}
+
+// GetIndexProvider only returns the validator index provider when filtering on validators alone, the delegators not
+// being indexed
+func (p *StakingFilter) GetIndexProvider() bstream.BlockIndexProvider {
+	if p.indexStore == nil {
+		return nil
+	}
+
+	if len(p.Validators) == 0 || len(p.Delegators) > 0 {
+		return nil
+	}
+
+	return NewValidatorIndexProvider(
+		p.indexStore,
+		p.possibleIndexSizes,
+		p.Validators,
+	)
+}
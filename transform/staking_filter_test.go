@@ -0,0 +1,213 @@
+package transform
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbfctype "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/type/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	msgBeginRedelegate = "/cosmos.staking.v1beta1.MsgBeginRedelegate"
+	otherValidator     = "cosmosvaloper1c4k24jzduc365kywrsvf5ujz4ya6mwymy8vq4q"
+	validatorAccount   = "cosmos1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u0tvx7u"
+)
+
+func withdrawCommissionMessage(validator string) *anypb.Any {
+	value := protowire.AppendTag(nil, 1, protowire.BytesType)
+	value = protowire.AppendString(value, validator)
+	return &anypb.Any{TypeUrl: MsgWithdrawValidatorCommission, Value: value}
+}
+
+// stakingBlock holds the rewards allocated by the begin blocker, a Cosmos SDK v0.45 transaction restaking rewards, a
+// Cosmos SDK v0.47 transaction redelegating tokens and withdrawing the commission of a validator, and an unbonding
+// completed by the end blocker
+func stakingBlock() *pbcosmos.Block {
+	restake := restakeTx()
+	restake.Index = 0
+	restake.Hash = []byte{0xaa}
+
+	redelegateTx := &pbcosmos.TxResult{
+		Index: 1,
+		Hash:  []byte{0xbb},
+		Tx: &pbcosmos.Tx{Body: &pbcosmos.TxBody{Messages: []*anypb.Any{
+			{TypeUrl: msgBeginRedelegate},
+			withdrawCommissionMessage(otherValidator),
+		}}},
+		Result: &pbcosmos.ResponseDeliverTx{Events: append(append(anteEvents(),
+			withMsgIndex("0",
+				event("message", "action", msgBeginRedelegate, "sender", delegator, "module", "staking"),
+				event("redelegate", "source_validator", validator, "destination_validator", otherValidator, "amount", "500uatom", "delegator", delegator, "completion_time", "2022-10-03T16:26:40Z"),
+			)...),
+			withMsgIndex("1",
+				event("message", "action", MsgWithdrawValidatorCommission, "sender", validatorAccount, "module", "distribution"),
+				event("withdraw_commission", "amount", "77uatom"),
+			)...,
+		)},
+	}
+
+	return &pbcosmos.Block{
+		Header: &pbcosmos.Header{Height: 12000003, Hash: []byte{0x0a}, Time: &pbcosmos.Timestamp{Seconds: 1663000000}},
+		ResultBeginBlock: &pbcosmos.ResponseBeginBlock{Events: []*pbcosmos.Event{
+			event("commission", "amount", "12.500000000000000000uatom", "validator", validator),
+			event("rewards", "amount", "125.000000000000000000uatom", "validator", validator),
+			event("commission", "amount", "3.100000000000000000uatom", "validator", otherValidator),
+			event("rewards", "amount", "31.000000000000000000uatom", "validator", otherValidator),
+		}},
+		ResultEndBlock: &pbcosmos.ResponseEndBlock{Events: []*pbcosmos.Event{
+			event("complete_unbonding", "amount", "2000uatom", "validator", otherValidator, "delegator", recipient),
+		}},
+		Transactions: []*pbcosmos.TxResult{restake, redelegateTx},
+	}
+}
+
+func TestStakingRecords(t *testing.T) {
+	records := StakingRecords(stakingBlock()).Records
+	require.Len(t, records, 9)
+
+	var types []pbfctype.StakingRecordType
+	for _, record := range records {
+		types = append(types, record.Type)
+	}
+	assert.Equal(t, []pbfctype.StakingRecordType{
+		pbfctype.StakingRecordType_STAKING_RECORD_TYPE_COMMISSION,
+		pbfctype.StakingRecordType_STAKING_RECORD_TYPE_REWARDS,
+		pbfctype.StakingRecordType_STAKING_RECORD_TYPE_COMMISSION,
+		pbfctype.StakingRecordType_STAKING_RECORD_TYPE_REWARDS,
+		pbfctype.StakingRecordType_STAKING_RECORD_TYPE_WITHDRAW_REWARDS,
+		pbfctype.StakingRecordType_STAKING_RECORD_TYPE_DELEGATE,
+		pbfctype.StakingRecordType_STAKING_RECORD_TYPE_REDELEGATE,
+		pbfctype.StakingRecordType_STAKING_RECORD_TYPE_WITHDRAW_COMMISSION,
+		pbfctype.StakingRecordType_STAKING_RECORD_TYPE_COMPLETE_UNDELEGATION,
+	}, types)
+
+	rewards := records[1]
+	assert.Equal(t, validator, rewards.Validator)
+	assert.Equal(t, "125.000000000000000000uatom", rewards.Amount)
+	assert.Equal(t, "", rewards.Delegator)
+	assert.Equal(t, pbfctype.EventOrigin_EVENT_ORIGIN_BEGIN_BLOCK, rewards.Origin)
+	assert.Equal(t, int32(-1), rewards.MsgIndex)
+
+	// The delegator of v0.45 events is the sender of the distribution and staking messages, not of the bank transfer
+	withdraw := records[4]
+	assert.Equal(t, delegator, withdraw.Delegator)
+	assert.Equal(t, validator, withdraw.Validator)
+	assert.Equal(t, "1034uatom", withdraw.Amount)
+	assert.Equal(t, []byte{0xaa}, withdraw.TxHash)
+	assert.Equal(t, int32(0), withdraw.MsgIndex)
+
+	delegate := records[5]
+	assert.Equal(t, delegator, delegate.Delegator)
+	assert.Equal(t, int32(1), delegate.MsgIndex)
+
+	redelegate := records[6]
+	assert.Equal(t, delegator, redelegate.Delegator)
+	assert.Equal(t, validator, redelegate.SourceValidator)
+	assert.Equal(t, otherValidator, redelegate.Validator)
+	assert.Equal(t, "2022-10-03T16:26:40Z", redelegate.CompletionTime)
+	assert.Equal(t, pbfctype.EventOrigin_EVENT_ORIGIN_DELIVER_TX, redelegate.Origin)
+
+	commission := records[7]
+	assert.Equal(t, otherValidator, commission.Validator)
+	assert.Equal(t, "", commission.Delegator)
+	assert.Equal(t, "77uatom", commission.Amount)
+	assert.Equal(t, uint32(1), commission.TxIndex)
+
+	unbonding := records[8]
+	assert.Equal(t, recipient, unbonding.Delegator)
+	assert.Equal(t, otherValidator, unbonding.Validator)
+	assert.Equal(t, pbfctype.EventOrigin_EVENT_ORIGIN_END_BLOCK, unbonding.Origin)
+	assert.Nil(t, unbonding.TxHash)
+}
+
+func TestStakingFilter_Transform(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegators    []string
+		validators    []string
+		expectedTypes []pbfctype.StakingRecordType
+	}{
+		{
+			name:       "delegator",
+			delegators: []string{recipient},
+			expectedTypes: []pbfctype.StakingRecordType{
+				pbfctype.StakingRecordType_STAKING_RECORD_TYPE_COMPLETE_UNDELEGATION,
+			},
+		},
+		{
+			name:       "source validator",
+			validators: []string{validator},
+			expectedTypes: []pbfctype.StakingRecordType{
+				pbfctype.StakingRecordType_STAKING_RECORD_TYPE_WITHDRAW_REWARDS,
+				pbfctype.StakingRecordType_STAKING_RECORD_TYPE_DELEGATE,
+				pbfctype.StakingRecordType_STAKING_RECORD_TYPE_REDELEGATE,
+			},
+		},
+		{
+			name:       "delegator or validator",
+			delegators: []string{recipient},
+			validators: []string{"cosmosvaloper1unknown"},
+			expectedTypes: []pbfctype.StakingRecordType{
+				pbfctype.StakingRecordType_STAKING_RECORD_TYPE_COMPLETE_UNDELEGATION,
+			},
+		},
+		{
+			name:       "unknown delegator",
+			delegators: []string{osmosisReceiver},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blk, err := codec.FromProto(stakingBlock())
+			require.NoError(t, err)
+
+			filter := &StakingFilter{Delegators: make(map[string]bool), Validators: make(map[string]bool)}
+			for _, delegator := range test.delegators {
+				filter.Delegators[delegator] = true
+			}
+			for _, validator := range test.validators {
+				filter.Validators[validator] = true
+			}
+
+			output, err := filter.Transform(blk, nil)
+			require.NoError(t, err)
+
+			var types []pbfctype.StakingRecordType
+			for _, record := range output.(*pbfctype.StakingBlock).Records {
+				types = append(types, record.Type)
+			}
+			assert.Equal(t, test.expectedTypes, types)
+		})
+	}
+}
+
+func TestValidatorIndexer(t *testing.T) {
+	keys, err := IndexKeys(ValidatorIndexShortName, stakingBlock())
+	require.NoError(t, err)
+
+	sort.Strings(keys)
+	assert.Equal(t, []string{otherValidator, validator}, keys)
+
+	// The allocations of the begin blocker are not indexed, every active validator having some in every block
+	block := stakingBlock()
+	block.Transactions = nil
+	block.ResultEndBlock = nil
+	block.ResultBeginBlock.Events = append(block.ResultBeginBlock.Events, event("proposer_reward", "amount", "1.500000000000000000uatom", "validator", validator))
+	keys, err = IndexKeys(ValidatorIndexShortName, block)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	blk, err := codec.FromProto(block)
+	require.NoError(t, err)
+	output, err := (&StakingFilter{Validators: map[string]bool{validator: true}}).Transform(blk, nil)
+	require.NoError(t, err)
+	assert.Empty(t, output.(*pbfctype.StakingBlock).Records)
+}
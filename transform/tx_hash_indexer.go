@@ -0,0 +1,77 @@
+package transform
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/dstore"
+)
+
+const (
+	TxHashIndexShortName = "txhash"
+
+	// TxHashIndexPrefixLength is the number of bytes of the transaction hashes used as index keys, keeping the index
+	// files small while making it unlikely for a lookup to match more than a block per index file
+	TxHashIndexPrefixLength = 4
+)
+
+func init() {
+	RegisterShardedIndexer(TxHashIndexShortName, TxHashIndexShards(), TxHashIndexShard, func(blockIndexer BlockIndexer) Indexer {
+		return &TxHashIndexer{BlockIndexer: blockIndexer}
+	})
+}
+
+// TxHashIndexShards returns the shards of the tx hash index, one per value of the first byte of the hashes, the
+// files of a shard being written in the `txhash/{shard}` sub store of the index store
+func TxHashIndexShards() []string {
+	out := make([]string, 256)
+	for i := range out {
+		out[i] = fmt.Sprintf("%02X", i)
+	}
+	return out
+}
+
+// TxHashIndexShard returns the shard of a key of the tx hash index, its first byte in uppercase hex
+func TxHashIndexShard(key string) string {
+	return key[:2]
+}
+
+// TxHashIndexer indexes the blocks by the prefix of the hashes of their transactions
+type TxHashIndexer struct {
+	BlockIndexer BlockIndexer
+}
+
+// NewTxHashIndexer creates a tx hash indexer writing the files of each shard of the index in its sub store
+func NewTxHashIndexer(indexStore dstore.Store, indexSize uint64, startBlock uint64) (*TxHashIndexer, error) {
+	indexer, err := NewIndexer(TxHashIndexShortName, indexStore, indexSize, startBlock)
+	if err != nil {
+		return nil, err
+	}
+	return indexer.(*TxHashIndexer), nil
+}
+
+func (i *TxHashIndexer) ProcessBlock(block *pbcosmos.Block) {
+	keyMap := make(map[string]bool)
+	for _, tx := range block.Transactions {
+		if len(tx.Hash) > 0 {
+			keyMap[TxHashIndexKey(tx.Hash)] = true
+		}
+	}
+
+	var keys []string
+	for key := range keyMap {
+		keys = append(keys, key)
+	}
+
+	i.BlockIndexer.Add(keys, block.Header.Height)
+}
+
+// TxHashIndexKey returns the key of the transaction hash in the tx hash index, its prefix in uppercase hex
+func TxHashIndexKey(hash []byte) string {
+	if len(hash) > TxHashIndexPrefixLength {
+		hash = hash[:TxHashIndexPrefixLength]
+	}
+	return strings.ToUpper(hex.EncodeToString(hash))
+}
@@ -0,0 +1,68 @@
+package transform
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxHashIndexer(t *testing.T) {
+	block := &pbcosmos.Block{
+		Header: &pbcosmos.Header{Height: 300},
+		Transactions: []*pbcosmos.TxResult{
+			{Hash: []byte{0x9d, 0x3c, 0x4a, 0xe0, 0xa5, 0xd1}},
+			{Hash: []byte{0x9d, 0x3c, 0x4a, 0xe0, 0x01, 0x02}},
+			{Hash: []byte{0x01, 0x02}},
+			{},
+		},
+	}
+
+	keys, err := IndexKeys(TxHashIndexShortName, block)
+	require.NoError(t, err)
+
+	sort.Strings(keys)
+	assert.Equal(t, []string{"0102", "9D3C4AE0"}, keys)
+}
+
+func TestTxHashIndexer_Shards(t *testing.T) {
+	indexStore, err := dstore.NewStore(t.TempDir(), "", "", false)
+	require.NoError(t, err)
+
+	indexer, err := NewTxHashIndexer(indexStore, 100, 0)
+	require.NoError(t, err)
+	for height := uint64(0); height <= 100; height++ {
+		block := &pbcosmos.Block{Header: &pbcosmos.Header{Height: height}}
+		switch height {
+		case 42:
+			block.Transactions = []*pbcosmos.TxResult{{Hash: []byte{0x9d, 0x3c, 0x4a, 0xe0, 0xa5, 0xd1}}, {Hash: []byte{0x01, 0x02}}}
+		case 57:
+			block.Transactions = []*pbcosmos.TxResult{{Hash: []byte{0x9d, 0x3c, 0x4a, 0xe0, 0xff}}}
+		}
+		indexer.ProcessBlock(block)
+	}
+
+	// The keys are written to the files of their shard only, the files of every shard being written
+	shard, err := IndexShardStore(indexStore, TxHashIndexShortName, "9D")
+	require.NoError(t, err)
+	reader, err := shard.OpenObject(context.Background(), "0000000000.100.txhash.idx")
+	require.NoError(t, err)
+	index, err := transform.ReadNewBlockIndex(reader)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{42, 57}, index.Get("9D3C4AE0").ToArray())
+	assert.Nil(t, index.Get("0102"))
+
+	for _, name := range []string{"01", "FF"} {
+		shard, err := IndexShardStore(indexStore, TxHashIndexShortName, name)
+		require.NoError(t, err)
+		exists, err := shard.FileExists(context.Background(), "0000000000.100.txhash.idx")
+		require.NoError(t, err)
+		assert.True(t, exists, "shard %s", name)
+	}
+}
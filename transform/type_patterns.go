@@ -0,0 +1,82 @@
+package transform
+
+import (
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+)
+
+// TypeWildcard matches any sequence of characters in message types and event types filters,
+// ex: `/cosmwasm.wasm.v1.*` or `ibc_*`
+const TypeWildcard = "*"
+
+func isTypePattern(value string) bool {
+	return strings.Contains(value, TypeWildcard)
+}
+
+// matchesType checks if value is one of types, either exactly or through a pattern
+func matchesType(types map[string]bool, value string) bool {
+	if types[value] {
+		return true
+	}
+	for t := range types {
+		if isTypePattern(t) && matchTypePattern(t, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchTypePattern(pattern, value string) bool {
+	parts := strings.Split(pattern, TypeWildcard)
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+
+	last := len(parts) - 1
+	for _, part := range parts[1:last] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+	return strings.HasSuffix(value, parts[last])
+}
+
+// indexableTypes checks if the blocks having one of types can be looked up in an index. The keys of an index bundle
+// are only searchable by prefix and suffix, so that the patterns starting and ending with a wildcard (ex: `*` or
+// `*wasm*`) cannot be looked up, the blocks then all being read.
+func indexableTypes(types map[string]bool) bool {
+	if len(types) == 0 {
+		return false
+	}
+	for t := range types {
+		if isTypePattern(t) && strings.HasPrefix(t, TypeWildcard) && strings.HasSuffix(t, TypeWildcard) {
+			return false
+		}
+	}
+	return true
+}
+
+// typeBitmap returns the blocks of the index bundle having one of the types. Patterns are expanded against the keys
+// of the bundle using their parts before the first and after the last wildcard, which may select a few more blocks
+// than needed for patterns with multiple wildcards, the filters then drop the extra types. The types must be
+// indexable, see indexableTypes.
+func typeBitmap(bitmaps transform.BitmapGetter, types map[string]bool) *roaring64.Bitmap {
+	out := roaring64.NewBitmap()
+	for t := range types {
+		var bm *roaring64.Bitmap
+		if isTypePattern(t) {
+			bm = bitmaps.GetByPrefixAndSuffix(t[:strings.Index(t, TypeWildcard)], t[strings.LastIndex(t, TypeWildcard)+1:])
+		} else {
+			bm = bitmaps.Get(t)
+		}
+		if bm != nil {
+			out.Or(bm)
+		}
+	}
+	return out
+}
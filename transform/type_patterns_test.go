@@ -0,0 +1,128 @@
+package transform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/dstore"
+	"github.com/stretchr/testify/assert"
+)
+
+type testBitmapGetter map[string]*roaring64.Bitmap
+
+func (g testBitmapGetter) Get(key string) *roaring64.Bitmap {
+	return g[key]
+}
+
+// GetByPrefixAndSuffix matches the bstream index, which ignores the lookups without prefix and suffix
+func (g testBitmapGetter) GetByPrefixAndSuffix(prefix, suffix string) *roaring64.Bitmap {
+	if prefix == "" && suffix == "" {
+		return nil
+	}
+	out := roaring64.NewBitmap()
+	for key, bm := range g {
+		if strings.HasPrefix(key, prefix) && strings.HasSuffix(key, suffix) {
+			out.Or(bm)
+		}
+	}
+	return out
+}
+
+func TestMatchesType(t *testing.T) {
+	tests := []struct {
+		types    []string
+		value    string
+		expected bool
+	}{
+		{[]string{"transfer"}, "transfer", true},
+		{[]string{"transfer"}, "transfer_packet", false},
+		{[]string{"/cosmwasm.wasm.v1.*"}, "/cosmwasm.wasm.v1.MsgExecuteContract", true},
+		{[]string{"/cosmwasm.wasm.v1.*"}, "/cosmwasm.wasm.v1", false},
+		{[]string{"/cosmwasm.wasm.v1.*"}, "/cosmos.bank.v1beta1.MsgSend", false},
+		{[]string{"*_packet"}, "send_packet", true},
+		{[]string{"*_packet"}, "packet", false},
+		{[]string{"/ibc.*.Msg*"}, "/ibc.core.channel.v1.MsgRecvPacket", true},
+		{[]string{"/ibc.*.Msg*"}, "/ibc.core.client.v1.Height", false},
+		{[]string{"a*b*b"}, "abb", true},
+		{[]string{"a*b*b"}, "ab", false},
+		{[]string{"transfer", "*"}, "anything", true},
+	}
+
+	for _, test := range tests {
+		t.Run(strings.Join(test.types, ",")+"/"+test.value, func(t *testing.T) {
+			types := make(map[string]bool)
+			for _, typ := range test.types {
+				types[typ] = true
+			}
+			assert.Equal(t, test.expected, matchesType(types, test.value))
+		})
+	}
+}
+
+func TestIndexableTypes(t *testing.T) {
+	tests := []struct {
+		types    []string
+		expected bool
+	}{
+		{nil, false},
+		{[]string{"transfer"}, true},
+		{[]string{"/cosmwasm.wasm.v1.*", "*_packet", "/ibc.*.Msg*"}, true},
+		{[]string{"transfer", "*"}, false},
+		{[]string{"*wasm*"}, false},
+		{[]string{"transfer", "*x*"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(strings.Join(test.types, ","), func(t *testing.T) {
+			types := make(map[string]bool)
+			for _, typ := range test.types {
+				types[typ] = true
+			}
+			assert.Equal(t, test.expected, indexableTypes(types))
+		})
+	}
+}
+
+func TestGetIndexProvider_UnindexableTypes(t *testing.T) {
+	store := dstore.NewMockStore(nil)
+	types := map[string]bool{"*wasm*": true}
+
+	assert.Nil(t, (&MessageTypeFilter{MessageTypes: types, indexStore: store}).GetIndexProvider())
+	assert.Nil(t, (&EventTypeFilter{EventTypes: types, indexStore: store}).GetIndexProvider())
+	assert.Nil(t, (&BalanceChangeFilter{Denoms: map[string]bool{"*uatom*": true}, indexStore: store}).GetIndexProvider())
+	assert.NotNil(t, (&EventTypeFilter{EventTypes: map[string]bool{"wasm*": true}, indexStore: store}).GetIndexProvider())
+}
+
+func TestTypeBitmap(t *testing.T) {
+	bitmaps := testBitmapGetter{
+		"/cosmwasm.wasm.v1.MsgExecuteContract":     roaring64.BitmapOf(10, 11),
+		"/cosmwasm.wasm.v1.MsgInstantiateContract": roaring64.BitmapOf(12),
+		"/cosmos.bank.v1beta1.MsgSend":             roaring64.BitmapOf(13),
+		"/ibc.core.channel.v1.MsgRecvPacket":       roaring64.BitmapOf(14),
+	}
+
+	tests := []struct {
+		name     string
+		types    []string
+		expected []uint64
+	}{
+		{"exact", []string{"/cosmos.bank.v1beta1.MsgSend"}, []uint64{13}},
+		{"prefix", []string{"/cosmwasm.wasm.v1.*"}, []uint64{10, 11, 12}},
+		{"suffix", []string{"*Packet"}, []uint64{14}},
+		{"pattern and exact", []string{"/cosmwasm.wasm.v1.*Contract", "/cosmos.bank.v1beta1.MsgSend"}, []uint64{10, 11, 12, 13}},
+		{"no match", []string{"/cosmos.gov.*"}, []uint64{}},
+		// The index cannot be searched without prefix nor suffix, the filters then read every block
+		{"inner pattern", []string{"*wasm*"}, []uint64{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			types := make(map[string]bool)
+			for _, typ := range test.types {
+				types[typ] = true
+			}
+			assert.Equal(t, test.expected, typeBitmap(bitmaps, types).ToArray())
+		})
+	}
+}
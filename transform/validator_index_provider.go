@@ -0,0 +1,34 @@
+package transform
+
+import (
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+const ValidatorIndexShortName = "validator"
+
+func NewValidatorIndexProvider(
+	store dstore.Store,
+	possibleIndexSizes []uint64,
+	validators map[string]bool,
+) *transform.GenericBlockIndexProvider {
+	return transform.NewGenericBlockIndexProvider(
+		store,
+		ValidatorIndexShortName,
+		possibleIndexSizes,
+		getValidatorFilterFunc(validators),
+	)
+}
+
+func getValidatorFilterFunc(validators map[string]bool) func(transform.BitmapGetter) []uint64 {
+	return func(bitmaps transform.BitmapGetter) (matchingBlocks []uint64) {
+		out := roaring64.NewBitmap()
+		for validator := range validators {
+			if bm := bitmaps.Get(validator); bm != nil {
+				out.Or(bm)
+			}
+		}
+		return nilIfEmpty(out.ToArray())
+	}
+}
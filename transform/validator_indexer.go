@@ -0,0 +1,54 @@
+package transform
+
+import (
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+func init() {
+	RegisterIndexer(ValidatorIndexShortName, func(blockIndexer BlockIndexer) Indexer {
+		return &ValidatorIndexer{BlockIndexer: blockIndexer}
+	})
+}
+
+// ValidatorIndexer indexes the operator addresses of the validators having staking records in each block. The
+// allocation records are not indexed, every block holding the ones of every active validator.
+type ValidatorIndexer struct {
+	BlockIndexer BlockIndexer
+}
+
+func NewValidatorIndexer(indexStore dstore.Store, indexSize uint64, startBlock uint64) *ValidatorIndexer {
+	bi := transform.NewBlockIndexer(
+		indexStore,
+		indexSize,
+		ValidatorIndexShortName,
+		transform.WithDefinedStartBlock(startBlock),
+	)
+
+	return &ValidatorIndexer{
+		BlockIndexer: bi,
+	}
+}
+
+func (i *ValidatorIndexer) ProcessBlock(block *pbcosmos.Block) {
+	keyMap := make(map[string]bool)
+	for _, record := range StakingRecords(block).Records {
+		if isAllocationRecord(record) {
+			continue
+		}
+		if record.Validator != "" {
+			keyMap[record.Validator] = true
+		}
+		if record.SourceValidator != "" {
+			keyMap[record.SourceValidator] = true
+		}
+	}
+
+	var keys []string
+	for key := range keyMap {
+		keys = append(keys, key)
+	}
+
+	i.BlockIndexer.Add(keys, block.Header.Height)
+}
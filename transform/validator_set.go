@@ -0,0 +1,26 @@
+package transform
+
+import (
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+// HasValidatorSetChange returns true when the block holds validator set updates, either reported by the node or
+// returned by the end blocker
+func HasValidatorSetChange(block *pbcosmos.Block) bool {
+	if len(block.ValidatorUpdates) > 0 {
+		return true
+	}
+	return block.ResultEndBlock != nil && len(block.ResultEndBlock.ValidatorUpdates) > 0
+}
+
+// ValidatorSetChanges returns a block holding only the header and the validator updates of block
+func ValidatorSetChanges(block *pbcosmos.Block) *pbcosmos.Block {
+	out := &pbcosmos.Block{
+		Header:           block.Header,
+		ValidatorUpdates: block.ValidatorUpdates,
+	}
+	if block.ResultEndBlock != nil && len(block.ResultEndBlock.ValidatorUpdates) > 0 {
+		out.ResultEndBlock = &pbcosmos.ResponseEndBlock{ValidatorUpdates: block.ResultEndBlock.ValidatorUpdates}
+	}
+	return out
+}
@@ -0,0 +1,65 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+var ValidatorSetChangeFilterMessageName = proto.MessageName(&pbfctransform.ValidatorSetChangeFilter{})
+
+func ValidatorSetChangeFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint64) *transform.Factory {
+	return &transform.Factory{
+		Obj: &pbfctransform.ValidatorSetChangeFilter{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			if message.MessageName() != ValidatorSetChangeFilterMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", ValidatorSetChangeFilterMessageName, message.TypeUrl)
+			}
+
+			filter := &pbfctransform.ValidatorSetChangeFilter{}
+			err := proto.Unmarshal(message.Value, filter)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected unmarshal error: %w", err)
+			}
+
+			return &ValidatorSetChangeFilter{
+				possibleIndexSizes: possibleIndexSizes,
+				indexStore:         indexStore,
+			}, nil
+		},
+	}
+}
+
+// ValidatorSetChangeFilter reduces the blocks to their header and validator updates, its index provider skipping the
+// blocks that do not change the validator set
+type ValidatorSetChangeFilter struct {
+	indexStore         dstore.Store
+	possibleIndexSizes []uint64
+}
+
+func (p *ValidatorSetChangeFilter) String() string {
+	return "validator set changes"
+}
+
+func (p *ValidatorSetChangeFilter) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
+	return ValidatorSetChanges(block), nil
+}
+
+func (p *ValidatorSetChangeFilter) GetIndexProvider() bstream.BlockIndexProvider {
+	if p.indexStore == nil {
+		return nil
+	}
+
+	return NewValidatorSetChangeIndexProvider(
+		p.indexStore,
+		p.possibleIndexSizes,
+	)
+}
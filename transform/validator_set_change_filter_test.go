@@ -0,0 +1,86 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validatorSetBlock(height uint64, nodeUpdates []*pbcosmos.Validator, endBlockUpdates []*pbcosmos.ValidatorUpdate) *pbcosmos.Block {
+	return &pbcosmos.Block{
+		Header:           &pbcosmos.Header{Height: height, Hash: []byte{0x04}, Time: &pbcosmos.Timestamp{Seconds: 1663000000}},
+		ResultBeginBlock: &pbcosmos.ResponseBeginBlock{Events: []*pbcosmos.Event{event("mint", "amount", "100uatom")}},
+		ResultEndBlock: &pbcosmos.ResponseEndBlock{
+			ValidatorUpdates: endBlockUpdates,
+			Events:           []*pbcosmos.Event{event("complete_unbonding", "validator", "cosmosvaloper1abc")},
+		},
+		Transactions: []*pbcosmos.TxResult{
+			{Index: 0, Tx: &pbcosmos.Tx{Body: &pbcosmos.TxBody{}}, Result: &pbcosmos.ResponseDeliverTx{Events: anteEvents()}},
+		},
+		ValidatorUpdates: nodeUpdates,
+	}
+}
+
+func TestValidatorSetChangeFilter_Transform(t *testing.T) {
+	pubKey := &pbcosmos.PublicKey{Sum: &pbcosmos.PublicKey_Ed25519{Ed25519: []byte{0x01, 0x02}}}
+	nodeUpdates := []*pbcosmos.Validator{{Address: []byte{0xab}, PubKey: pubKey, VotingPower: 1000}}
+	endBlockUpdates := []*pbcosmos.ValidatorUpdate{{PubKey: pubKey, Power: 1000}}
+
+	tests := []struct {
+		name            string
+		block           *pbcosmos.Block
+		expectedChanged bool
+	}{
+		{
+			name:            "node and end block updates",
+			block:           validatorSetBlock(100, nodeUpdates, endBlockUpdates),
+			expectedChanged: true,
+		},
+		{
+			name:            "end block updates only",
+			block:           validatorSetBlock(101, nil, endBlockUpdates),
+			expectedChanged: true,
+		},
+		{
+			name:  "no updates",
+			block: validatorSetBlock(102, nil, nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blk, err := codec.FromProto(test.block)
+			require.NoError(t, err)
+
+			output, err := (&ValidatorSetChangeFilter{}).Transform(blk, nil)
+			require.NoError(t, err)
+			block := output.(*pbcosmos.Block)
+
+			assert.Equal(t, test.block.Header.Height, block.Header.Height)
+			assert.Equal(t, test.block.Header.Hash, block.Header.Hash)
+			assert.Nil(t, block.ResultBeginBlock)
+			assert.Len(t, block.Transactions, 0)
+			assert.Len(t, block.ValidatorUpdates, len(test.block.ValidatorUpdates))
+			if len(test.block.ResultEndBlock.ValidatorUpdates) > 0 {
+				require.NotNil(t, block.ResultEndBlock)
+				assert.Len(t, block.ResultEndBlock.ValidatorUpdates, len(test.block.ResultEndBlock.ValidatorUpdates))
+				assert.Len(t, block.ResultEndBlock.Events, 0)
+			} else {
+				assert.Nil(t, block.ResultEndBlock)
+			}
+			assert.Equal(t, test.expectedChanged, HasValidatorSetChange(block))
+
+			keys, err := IndexKeys(ValidatorSetChangeIndexShortName, test.block)
+			require.NoError(t, err)
+			if test.expectedChanged {
+				assert.Equal(t, []string{ValidatorSetChangeIndexKey}, keys)
+			} else {
+				assert.Empty(t, keys)
+			}
+		})
+	}
+}
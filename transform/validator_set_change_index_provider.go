@@ -0,0 +1,35 @@
+package transform
+
+import (
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+const (
+	ValidatorSetChangeIndexShortName = "validatorset"
+
+	// ValidatorSetChangeIndexKey is the single key of the index, marking the blocks changing the validator set
+	ValidatorSetChangeIndexKey = "changed"
+)
+
+func NewValidatorSetChangeIndexProvider(
+	store dstore.Store,
+	possibleIndexSizes []uint64,
+) *transform.GenericBlockIndexProvider {
+	return transform.NewGenericBlockIndexProvider(
+		store,
+		ValidatorSetChangeIndexShortName,
+		possibleIndexSizes,
+		getValidatorSetChangeFilterFunc(),
+	)
+}
+
+func getValidatorSetChangeFilterFunc() func(transform.BitmapGetter) []uint64 {
+	return func(bitmaps transform.BitmapGetter) (matchingBlocks []uint64) {
+		bm := bitmaps.Get(ValidatorSetChangeIndexKey)
+		if bm == nil {
+			return nil
+		}
+		return nilIfEmpty(bm.ToArray())
+	}
+}
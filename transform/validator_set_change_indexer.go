@@ -0,0 +1,40 @@
+package transform
+
+import (
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+func init() {
+	RegisterIndexer(ValidatorSetChangeIndexShortName, func(blockIndexer BlockIndexer) Indexer {
+		return &ValidatorSetChangeIndexer{BlockIndexer: blockIndexer}
+	})
+}
+
+// ValidatorSetChangeIndexer marks the blocks holding validator set updates
+type ValidatorSetChangeIndexer struct {
+	BlockIndexer BlockIndexer
+}
+
+func NewValidatorSetChangeIndexer(indexStore dstore.Store, indexSize uint64, startBlock uint64) *ValidatorSetChangeIndexer {
+	bi := transform.NewBlockIndexer(
+		indexStore,
+		indexSize,
+		ValidatorSetChangeIndexShortName,
+		transform.WithDefinedStartBlock(startBlock),
+	)
+
+	return &ValidatorSetChangeIndexer{
+		BlockIndexer: bi,
+	}
+}
+
+func (i *ValidatorSetChangeIndexer) ProcessBlock(block *pbcosmos.Block) {
+	var keys []string
+	if HasValidatorSetChange(block) {
+		keys = append(keys, ValidatorSetChangeIndexKey)
+	}
+
+	i.BlockIndexer.Add(keys, block.Header.Height)
+}
@@ -0,0 +1,108 @@
+package transform
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	WasmMsgExecuteContract      = "/cosmwasm.wasm.v1.MsgExecuteContract"
+	WasmMsgInstantiateContract  = "/cosmwasm.wasm.v1.MsgInstantiateContract"
+	WasmMsgInstantiateContract2 = "/cosmwasm.wasm.v1.MsgInstantiateContract2"
+	WasmMsgMigrateContract      = "/cosmwasm.wasm.v1.MsgMigrateContract"
+)
+
+// wasmContractAddressKeys are the attributes holding the contract address in the events emitted by wasmd,
+// `contract_address` being used by versions prior to 0.16
+var wasmContractAddressKeys = []string{"_contract_address", "contract_address"}
+
+const wasmInstantiateEventType = "instantiate"
+
+// wasmMessage holds the fields of the CosmWasm messages needed to filter them, decoded without depending on wasmd
+type wasmMessage struct {
+	typeURL  string
+	contract string // empty for instantiate messages, the address is only known from their events
+	msg      []byte // JSON message sent to the contract
+}
+
+func isWasmMessage(typeURL string) bool {
+	switch typeURL {
+	case WasmMsgExecuteContract, WasmMsgInstantiateContract, WasmMsgInstantiateContract2, WasmMsgMigrateContract:
+		return true
+	}
+	return false
+}
+
+func decodeWasmMessage(message *anypb.Any) (*wasmMessage, error) {
+	var contractField, msgField protowire.Number
+	switch message.TypeUrl {
+	case WasmMsgExecuteContract:
+		contractField, msgField = 2, 3
+	case WasmMsgInstantiateContract, WasmMsgInstantiateContract2:
+		msgField = 5
+	case WasmMsgMigrateContract:
+		contractField, msgField = 2, 4
+	default:
+		return nil, fmt.Errorf("unsupported wasm message type %q", message.TypeUrl)
+	}
+
+	out := &wasmMessage{typeURL: message.TypeUrl}
+	data := message.Value
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, fmt.Errorf("decoding %s: %w", message.TypeUrl, protowire.ParseError(n))
+		}
+		data = data[n:]
+
+		if typ == protowire.BytesType && (num == contractField || num == msgField) {
+			value, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return nil, fmt.Errorf("decoding %s: %w", message.TypeUrl, protowire.ParseError(n))
+			}
+			if num == contractField {
+				out.contract = string(value)
+			} else {
+				out.msg = value
+			}
+			data = data[n:]
+			continue
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, data)
+		if n < 0 {
+			return nil, fmt.Errorf("decoding %s: %w", message.TypeUrl, protowire.ParseError(n))
+		}
+		data = data[n:]
+	}
+	return out, nil
+}
+
+// methods returns the top-level keys of the JSON message, CosmWasm contracts expect a single one naming the method
+func (m *wasmMessage) methods() []string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(m.msg, &fields); err != nil {
+		return nil
+	}
+	out := make([]string, 0, len(fields))
+	for field := range fields {
+		out = append(out, field)
+	}
+	return out
+}
+
+// wasmEventContract returns the address of the contract that emitted the event, if any
+func wasmEventContract(event *pbcosmos.Event) (string, bool) {
+	for _, attr := range event.Attributes {
+		for _, key := range wasmContractAddressKeys {
+			if string(attr.Key) == key {
+				return string(attr.Value), true
+			}
+		}
+	}
+	return "", false
+}
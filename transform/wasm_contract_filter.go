@@ -0,0 +1,209 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	pbfctransform "github.com/graphprotocol/firehose-cosmos/pb/sf/firecosmos/transform/v1"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+)
+
+var WasmContractFilterMessageName = proto.MessageName(&pbfctransform.WasmContractFilter{})
+
+func WasmContractFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint64) *transform.Factory {
+	return &transform.Factory{
+		Obj: &pbfctransform.WasmContractFilter{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			if message.MessageName() != WasmContractFilterMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", WasmContractFilterMessageName, message.TypeUrl)
+			}
+
+			filter := &pbfctransform.WasmContractFilter{}
+			err := proto.Unmarshal(message.Value, filter)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected unmarshal error: %w", err)
+			}
+
+			if len(filter.ContractAddresses) == 0 {
+				return nil, fmt.Errorf("wasm contract filter requires at least one contract address")
+			}
+
+			contractMap := make(map[string]bool)
+			for _, acc := range filter.ContractAddresses {
+				contractMap[acc] = true
+			}
+
+			methodMap := make(map[string]bool)
+			for _, method := range filter.Methods {
+				methodMap[method] = true
+			}
+
+			return &WasmContractFilter{
+				ContractAddresses:  contractMap,
+				Methods:            methodMap,
+				possibleIndexSizes: possibleIndexSizes,
+				indexStore:         indexStore,
+			}, nil
+		},
+	}
+}
+
+// WasmContractFilter keeps the CosmWasm messages sent to ContractAddresses and the events those contracts emitted,
+// dropping the transactions left without any. When Methods is not empty, only the execute messages calling one of
+// them are kept, along with the events they caused.
+type WasmContractFilter struct {
+	ContractAddresses map[string]bool
+	Methods           map[string]bool
+
+	indexStore         dstore.Store
+	possibleIndexSizes []uint64
+}
+
+func (p *WasmContractFilter) String() string {
+	return fmt.Sprintf("contracts: %v, methods: %v", p.ContractAddresses, p.Methods)
+}
+
+func (p *WasmContractFilter) Transform(readOnlyBlk *bstream.Block, in transform.Input) (transform.Output, error) {
+	block := readOnlyBlk.ToProtocol().(*pbcosmos.Block)
+
+	if block.ResultBeginBlock != nil {
+		block.ResultBeginBlock.Events = p.filterBlockEvents(block.ResultBeginBlock.Events)
+	}
+	if block.ResultEndBlock != nil {
+		block.ResultEndBlock.Events = p.filterBlockEvents(block.ResultEndBlock.Events)
+	}
+
+	var transactions []*pbcosmos.TxResult
+	for _, tx := range block.Transactions {
+		if p.filterTransaction(tx) {
+			transactions = append(transactions, tx)
+		}
+	}
+	block.Transactions = transactions
+
+	return block, nil
+}
+
+func (p *WasmContractFilter) filterBlockEvents(events []*pbcosmos.Event) []*pbcosmos.Event {
+	var outEvents []*pbcosmos.Event
+
+	for _, event := range events {
+		if contract, ok := wasmEventContract(event); ok && p.ContractAddresses[contract] {
+			outEvents = append(outEvents, event)
+		}
+	}
+
+	return outEvents
+}
+
+// filterTransaction keeps the messages and events of the contracts in the transaction, returning false when none is left
+func (p *WasmContractFilter) filterTransaction(tx *pbcosmos.TxResult) bool {
+	if tx.Tx == nil || tx.Tx.Body == nil || tx.Result == nil {
+		return false
+	}
+
+	msgIndexes, correlated := EventMessageIndexes(tx.Result)
+
+	// Instantiated contracts addresses are only found in the events, by message index when they can be correlated
+	instantiated := make(map[int][]string)
+	for i, event := range tx.Result.Events {
+		if event.EventType != wasmInstantiateEventType {
+			continue
+		}
+		if contract, ok := wasmEventContract(event); ok {
+			msgIndex := txLevelEvent
+			if correlated {
+				msgIndex = msgIndexes[i]
+			}
+			instantiated[msgIndex] = append(instantiated[msgIndex], contract)
+		}
+	}
+
+	var messages []*anypb.Any
+	keptIndexes := make(map[int]bool)
+	for i, message := range tx.Tx.Body.Messages {
+		if !isWasmMessage(message.TypeUrl) {
+			continue
+		}
+		wasmMsg, err := decodeWasmMessage(message)
+		if err != nil {
+			zlog.Debug("skipping invalid wasm message", zap.Uint64("height", tx.Height), zap.Uint32("index", tx.Index), zap.Error(err))
+			continue
+		}
+
+		if p.keepMessage(wasmMsg, instantiated[i], instantiated[txLevelEvent]) {
+			messages = append(messages, message)
+			keptIndexes[i] = true
+		}
+	}
+
+	var events []*pbcosmos.Event
+	for i, event := range tx.Result.Events {
+		contract, ok := wasmEventContract(event)
+		if !ok || !p.ContractAddresses[contract] {
+			continue
+		}
+		if len(p.Methods) > 0 && correlated && msgIndexes[i] != txLevelEvent && !keptIndexes[msgIndexes[i]] {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	tx.Tx.Body.Messages = messages
+	tx.Result.Events = events
+
+	return len(messages) > 0 || len(events) > 0
+}
+
+func (p *WasmContractFilter) keepMessage(message *wasmMessage, instantiated []string, uncorrelatedInstantiated []string) bool {
+	switch message.typeURL {
+	case WasmMsgInstantiateContract, WasmMsgInstantiateContract2:
+		for _, contracts := range [][]string{instantiated, uncorrelatedInstantiated} {
+			for _, contract := range contracts {
+				if p.ContractAddresses[contract] {
+					return true
+				}
+			}
+		}
+		return false
+
+	case WasmMsgExecuteContract:
+		if !p.ContractAddresses[message.contract] {
+			return false
+		}
+		if len(p.Methods) == 0 {
+			return true
+		}
+		for _, method := range message.methods() {
+			if p.Methods[method] {
+				return true
+			}
+		}
+		return false
+
+	default:
+		return p.ContractAddresses[message.contract]
+	}
+}
+
+func (p *WasmContractFilter) GetIndexProvider() bstream.BlockIndexProvider {
+	if p.indexStore == nil {
+		return nil
+	}
+
+	if len(p.ContractAddresses) == 0 {
+		return nil
+	}
+
+	return NewWasmContractIndexProvider(
+		p.indexStore,
+		p.possibleIndexSizes,
+		p.ContractAddresses,
+	)
+}
@@ -0,0 +1,213 @@
+package transform
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/graphprotocol/firehose-cosmos/codec"
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	cw20Contract  = "juno1x5xz6wu8qlau8znmc60tmazzj3ta98quhk7qkamul3am2x8fsaqqcwy7n9"
+	daoContract   = "juno1vn6ks4anxpv5r3ytdx6ypgh4m8mjxh9v3rc6hx5hlzgjgfkjv5vq4ahjn0"
+	otherContract = "juno1mkw83sv6c7sjdvsaplrzc8yaes9l42p4mhy0ssuxjnyzl87c9eps7ce3m9"
+	wasmSender    = "juno1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42"
+)
+
+func wasmAny(typeURL string, fields ...interface{}) *anypb.Any {
+	var value []byte
+	for i := 0; i < len(fields); i += 2 {
+		num := protowire.Number(fields[i].(int))
+		switch v := fields[i+1].(type) {
+		case string:
+			value = protowire.AppendTag(value, num, protowire.BytesType)
+			value = protowire.AppendString(value, v)
+		case uint64:
+			value = protowire.AppendTag(value, num, protowire.VarintType)
+			value = protowire.AppendVarint(value, v)
+		}
+	}
+	return &anypb.Any{TypeUrl: typeURL, Value: value}
+}
+
+func executeContract(contract, msg string) *anypb.Any {
+	return wasmAny(WasmMsgExecuteContract, 1, wasmSender, 2, contract, 3, msg)
+}
+
+func instantiateContract(codeID uint64, msg string) *anypb.Any {
+	return wasmAny(WasmMsgInstantiateContract, 1, wasmSender, 2, wasmSender, 3, codeID, 4, "dao-core", 5, msg)
+}
+
+// wasmBlock holds Cosmos SDK v0.47 transactions, which events have msg_index attributes
+func wasmBlock() *pbcosmos.Block {
+	transferTx := &pbcosmos.TxResult{
+		Index: 0,
+		Tx: &pbcosmos.Tx{Body: &pbcosmos.TxBody{Messages: []*anypb.Any{
+			executeContract(cw20Contract, `{"transfer":{"recipient":"`+wasmSender+`","amount":"1000"}}`),
+			{TypeUrl: msgSend},
+		}}},
+		Result: &pbcosmos.ResponseDeliverTx{Events: append(
+			append(anteEvents(), withMsgIndex("0",
+				event("message", "action", WasmMsgExecuteContract, "sender", wasmSender, "module", "wasm"),
+				event("execute", "_contract_address", cw20Contract),
+				event("wasm", "_contract_address", cw20Contract, "action", "transfer", "from", wasmSender, "to", wasmSender, "amount", "1000"),
+			)...),
+			withMsgIndex("1",
+				event("message", "action", msgSend, "sender", wasmSender, "module", "bank"),
+				event("transfer", "recipient", recipient, "sender", wasmSender, "amount", "10ujuno"),
+			)...,
+		)},
+	}
+
+	instantiateTx := &pbcosmos.TxResult{
+		Index: 1,
+		Tx: &pbcosmos.Tx{Body: &pbcosmos.TxBody{Messages: []*anypb.Any{
+			instantiateContract(1, `{"name":"dao"}`),
+		}}},
+		Result: &pbcosmos.ResponseDeliverTx{Events: append(anteEvents(), withMsgIndex("0",
+			event("message", "action", WasmMsgInstantiateContract, "sender", wasmSender, "module", "wasm"),
+			event("instantiate", "_contract_address", daoContract, "code_id", "1"),
+			event("wasm", "_contract_address", daoContract, "action", "instantiate"),
+		)...)},
+	}
+
+	otherTx := &pbcosmos.TxResult{
+		Index: 2,
+		Tx: &pbcosmos.Tx{Body: &pbcosmos.TxBody{Messages: []*anypb.Any{
+			executeContract(otherContract, `{"swap":{}}`),
+		}}},
+		Result: &pbcosmos.ResponseDeliverTx{Events: append(anteEvents(), withMsgIndex("0",
+			event("execute", "_contract_address", otherContract),
+		)...)},
+	}
+
+	return &pbcosmos.Block{
+		Header:           &pbcosmos.Header{Height: 5000000, Hash: []byte{0x02}, Time: &pbcosmos.Timestamp{Seconds: 1663000000}},
+		ResultBeginBlock: &pbcosmos.ResponseBeginBlock{Events: []*pbcosmos.Event{event("mint", "amount", "100ujuno")}},
+		ResultEndBlock: &pbcosmos.ResponseEndBlock{Events: []*pbcosmos.Event{
+			event("sudo", "_contract_address", cw20Contract),
+			event("sudo", "_contract_address", otherContract),
+		}},
+		Transactions: []*pbcosmos.TxResult{transferTx, instantiateTx, otherTx},
+	}
+}
+
+func TestWasmContractFilter_Transform(t *testing.T) {
+	type expectedTx struct {
+		index      uint32
+		messages   []string
+		eventTypes []string
+	}
+
+	tests := []struct {
+		name              string
+		contracts         []string
+		methods           []string
+		expectedEndEvents int
+		expectedTxs       []expectedTx
+	}{
+		{
+			name:              "execute",
+			contracts:         []string{cw20Contract},
+			expectedEndEvents: 1,
+			expectedTxs: []expectedTx{
+				{index: 0, messages: []string{WasmMsgExecuteContract}, eventTypes: []string{"execute", "wasm"}},
+			},
+		},
+		{
+			name:              "execute with matching method",
+			contracts:         []string{cw20Contract},
+			methods:           []string{"transfer", "send"},
+			expectedEndEvents: 1,
+			expectedTxs: []expectedTx{
+				{index: 0, messages: []string{WasmMsgExecuteContract}, eventTypes: []string{"execute", "wasm"}},
+			},
+		},
+		{
+			name:              "execute without matching method",
+			contracts:         []string{cw20Contract},
+			methods:           []string{"mint"},
+			expectedEndEvents: 1,
+		},
+		{
+			name:      "instantiate",
+			contracts: []string{daoContract},
+			expectedTxs: []expectedTx{
+				{index: 1, messages: []string{WasmMsgInstantiateContract}, eventTypes: []string{"instantiate", "wasm"}},
+			},
+		},
+		{
+			name:      "unknown contract",
+			contracts: []string{"juno1unknown"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blk, err := codec.FromProto(wasmBlock())
+			require.NoError(t, err)
+
+			filter := &WasmContractFilter{ContractAddresses: make(map[string]bool), Methods: make(map[string]bool)}
+			for _, contract := range test.contracts {
+				filter.ContractAddresses[contract] = true
+			}
+			for _, method := range test.methods {
+				filter.Methods[method] = true
+			}
+
+			output, err := filter.Transform(blk, nil)
+			require.NoError(t, err)
+			block := output.(*pbcosmos.Block)
+
+			assert.Len(t, block.ResultBeginBlock.Events, 0)
+			assert.Len(t, block.ResultEndBlock.Events, test.expectedEndEvents)
+
+			require.Len(t, block.Transactions, len(test.expectedTxs))
+			for i, expected := range test.expectedTxs {
+				tx := block.Transactions[i]
+				assert.Equal(t, expected.index, tx.Index)
+
+				var messages []string
+				for _, message := range tx.Tx.Body.Messages {
+					messages = append(messages, message.TypeUrl)
+				}
+				assert.Equal(t, expected.messages, messages)
+				assert.Equal(t, expected.eventTypes, eventTypes(tx.Result.Events))
+			}
+		})
+	}
+}
+
+func TestWasmContractIndexer(t *testing.T) {
+	keys, err := IndexKeys(WasmContractIndexShortName, wasmBlock())
+	require.NoError(t, err)
+
+	sort.Strings(keys)
+	assert.Equal(t, []string{otherContract, daoContract, cw20Contract}, keys)
+}
+
+func TestDecodeWasmMessage(t *testing.T) {
+	message, err := decodeWasmMessage(executeContract(cw20Contract, `{"transfer":{}}`))
+	require.NoError(t, err)
+	assert.Equal(t, cw20Contract, message.contract)
+	assert.Equal(t, []string{"transfer"}, message.methods())
+
+	message, err = decodeWasmMessage(instantiateContract(12, `{"name":"dao"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "", message.contract)
+	assert.Equal(t, `{"name":"dao"}`, string(message.msg))
+
+	message, err = decodeWasmMessage(wasmAny(WasmMsgMigrateContract, 1, wasmSender, 2, daoContract, 3, uint64(2), 4, `{}`))
+	require.NoError(t, err)
+	assert.Equal(t, daoContract, message.contract)
+	assert.Equal(t, `{}`, string(message.msg))
+
+	_, err = decodeWasmMessage(&anypb.Any{TypeUrl: WasmMsgExecuteContract, Value: []byte{0x12, 0x20, 0x01}})
+	assert.Error(t, err)
+}
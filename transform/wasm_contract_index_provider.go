@@ -0,0 +1,34 @@
+package transform
+
+import (
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+const WasmContractIndexShortName = "wasmcontract"
+
+func NewWasmContractIndexProvider(
+	store dstore.Store,
+	possibleIndexSizes []uint64,
+	contractAddresses map[string]bool,
+) *transform.GenericBlockIndexProvider {
+	return transform.NewGenericBlockIndexProvider(
+		store,
+		WasmContractIndexShortName,
+		possibleIndexSizes,
+		getWasmContractFilterFunc(contractAddresses),
+	)
+}
+
+func getWasmContractFilterFunc(contractAddresses map[string]bool) func(transform.BitmapGetter) []uint64 {
+	return func(bitmaps transform.BitmapGetter) (matchingBlocks []uint64) {
+		out := roaring64.NewBitmap()
+		for contract := range contractAddresses {
+			if bm := bitmaps.Get(contract); bm != nil {
+				out.Or(bm)
+			}
+		}
+		return nilIfEmpty(out.ToArray())
+	}
+}
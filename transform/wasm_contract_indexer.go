@@ -0,0 +1,73 @@
+package transform
+
+import (
+	pbcosmos "github.com/graphprotocol/proto-cosmos/pb/sf/cosmos/type/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+)
+
+func init() {
+	RegisterIndexer(WasmContractIndexShortName, func(blockIndexer BlockIndexer) Indexer {
+		return &WasmContractIndexer{BlockIndexer: blockIndexer}
+	})
+}
+
+// WasmContractIndexer indexes the addresses of the CosmWasm contracts called by messages or emitting events in each block
+type WasmContractIndexer struct {
+	BlockIndexer BlockIndexer
+}
+
+func NewWasmContractIndexer(indexStore dstore.Store, indexSize uint64, startBlock uint64) *WasmContractIndexer {
+	bi := transform.NewBlockIndexer(
+		indexStore,
+		indexSize,
+		WasmContractIndexShortName,
+		transform.WithDefinedStartBlock(startBlock),
+	)
+
+	return &WasmContractIndexer{
+		BlockIndexer: bi,
+	}
+}
+
+func (i *WasmContractIndexer) ProcessBlock(block *pbcosmos.Block) {
+	keyMap := make(map[string]bool)
+
+	if block.ResultBeginBlock != nil {
+		processWasmEvents(keyMap, block.ResultBeginBlock.Events)
+	}
+	if block.ResultEndBlock != nil {
+		processWasmEvents(keyMap, block.ResultEndBlock.Events)
+	}
+
+	for _, tx := range block.Transactions {
+		if tx.Tx != nil && tx.Tx.Body != nil {
+			for _, message := range tx.Tx.Body.Messages {
+				if !isWasmMessage(message.TypeUrl) {
+					continue
+				}
+				if wasmMsg, err := decodeWasmMessage(message); err == nil && wasmMsg.contract != "" {
+					keyMap[wasmMsg.contract] = true
+				}
+			}
+		}
+		if tx.Result != nil {
+			processWasmEvents(keyMap, tx.Result.Events)
+		}
+	}
+
+	var keys []string
+	for key := range keyMap {
+		keys = append(keys, key)
+	}
+
+	i.BlockIndexer.Add(keys, block.Header.Height)
+}
+
+func processWasmEvents(keyMap map[string]bool, events []*pbcosmos.Event) {
+	for _, event := range events {
+		if contract, ok := wasmEventContract(event); ok {
+			keyMap[contract] = true
+		}
+	}
+}